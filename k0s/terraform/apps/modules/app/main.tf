@@ -81,21 +81,26 @@ resource "homarr_app" "this" {
 }
 
 # Homarr integration
-# Most integrations require an API key - only create when provided
+# Most integrations require credentials - only create when provided
 locals {
-  # Integrations that work without API key (most need credentials)
-  no_api_key_kinds = []
-  needs_api_key    = var.integration_kind != null && !contains(local.no_api_key_kinds, var.integration_kind)
-  has_api_key      = var.api_key != null && var.api_key != ""
+  # Integrations that work without credentials
+  no_credentials_kinds = []
+  needs_credentials    = var.integration_kind != null && !contains(local.no_credentials_kinds, var.integration_kind)
+  has_api_key          = var.api_key != null && var.api_key != ""
+  # Secret kinds aren't secret, only their values
+  has_secrets     = length(nonsensitive(keys(var.integration_secrets))) > 0
+  has_credentials = local.has_api_key || local.has_secrets
 }
 
 resource "homarr_integration" "this" {
-  count = var.create_homarr_integration && var.integration_kind != null && (!local.needs_api_key || local.has_api_key) ? 1 : 0
+  count = var.create_homarr_integration && var.integration_kind != null && (!local.needs_credentials || local.has_credentials) ? 1 : 0
 
   name    = var.name
   kind    = var.integration_kind
   url     = var.internal_url
   api_key = var.api_key
+  secrets = local.has_secrets ? var.integration_secrets : null
+  app_id  = length(homarr_app.this) > 0 ? homarr_app.this[0].id : null
 }
 
 # Homarr search engine
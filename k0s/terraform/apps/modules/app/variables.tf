@@ -82,6 +82,13 @@ variable "api_key" {
   sensitive   = true
 }
 
+variable "integration_secrets" {
+  description = "Other Homarr integration credentials keyed by secret kind (username, password, tokenId, realm, personalAccessToken, topic, ...)"
+  type        = map(string)
+  default     = {}
+  sensitive   = true
+}
+
 # Feature flags
 variable "create_authentik_app" {
   description = "Create Authentik application"
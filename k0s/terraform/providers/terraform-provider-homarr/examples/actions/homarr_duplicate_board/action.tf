@@ -0,0 +1,27 @@
+resource "homarr_group" "household" {
+  for_each = toset(["alice", "bob"])
+
+  name = "household-${each.key}"
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.homarr_duplicate_board.household[each.key]]
+    }
+  }
+}
+
+action "homarr_duplicate_board" "household" {
+  for_each = toset(["alice", "bob"])
+
+  config {
+    board_name     = "template"
+    name           = "home-${each.key}"
+    is_public      = false
+    skip_if_exists = true
+
+    group_permissions = {
+      (homarr_group.household[each.key].id) = "modify"
+    }
+  }
+}
@@ -0,0 +1,234 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ action.Action = &DuplicateBoardAction{}
+var _ action.ActionWithConfigure = &DuplicateBoardAction{}
+var _ action.ActionWithValidateConfig = &DuplicateBoardAction{}
+
+// boardPermissionValues are the permission levels that can be granted on a board
+var boardPermissionValues = []string{"view", "modify", "full"}
+
+func NewDuplicateBoardAction() action.Action {
+	return &DuplicateBoardAction{}
+}
+
+// DuplicateBoardAction defines the action implementation.
+type DuplicateBoardAction struct {
+	client *HomarrClient
+}
+
+// DuplicateBoardActionModel describes the action data model.
+type DuplicateBoardActionModel struct {
+	BoardID          types.String `tfsdk:"board_id"`
+	BoardName        types.String `tfsdk:"board_name"`
+	Name             types.String `tfsdk:"name"`
+	IsPublic         types.Bool   `tfsdk:"is_public"`
+	SkipIfExists     types.Bool   `tfsdk:"skip_if_exists"`
+	UserPermissions  types.Map    `tfsdk:"user_permissions"`
+	GroupPermissions types.Map    `tfsdk:"group_permissions"`
+}
+
+func (a *DuplicateBoardAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_duplicate_board"
+}
+
+func (a *DuplicateBoardAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Duplicates a board with all of its sections and items under a new name, optionally setting visibility " +
+			"and permissions on the copy. Requires session_token authentication.",
+
+		Attributes: map[string]schema.Attribute{
+			"board_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The ID of the board to duplicate. Exactly one of `board_id` or `board_name` must be set.",
+			},
+			"board_name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The name of the board to duplicate. Exactly one of `board_id` or `board_name` must be set.",
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the new board.",
+			},
+			"is_public": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Whether the new board is public. Defaults to the visibility of the source board.",
+			},
+			"skip_if_exists": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Do nothing if a board with the new name already exists, instead of failing. Defaults to `false`.",
+			},
+			"user_permissions": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Permissions to grant on the new board, keyed by user ID. Values are `view`, `modify` or `full`.",
+				Validators: []validator.Map{
+					mapValuesOneOf(boardPermissionValues...),
+				},
+			},
+			"group_permissions": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Permissions to grant on the new board, keyed by group ID. Values are `view`, `modify` or `full`.",
+				Validators: []validator.Map{
+					mapValuesOneOf(boardPermissionValues...),
+				},
+			},
+		},
+	}
+}
+
+func (a *DuplicateBoardAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var data DuplicateBoardActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.BoardID.IsUnknown() || data.BoardName.IsUnknown() {
+		return
+	}
+
+	if data.BoardID.IsNull() == data.BoardName.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("board_id"),
+			"Invalid Board Selector",
+			"Exactly one of board_id or board_name must be set.",
+		)
+	}
+}
+
+func (a *DuplicateBoardAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*HomarrClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *HomarrClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = client
+}
+
+func (a *DuplicateBoardAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data DuplicateBoardActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if a.client.SessionToken == "" {
+		resp.Diagnostics.AddError("Missing Session Token", "Boards require session_token authentication. Please configure session_token in the provider.")
+		return
+	}
+
+	boards, err := a.client.GetBoards()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list boards: %s", err))
+		return
+	}
+
+	var source *Board
+	for i, b := range boards {
+		if (!data.BoardID.IsNull() && b.ID == data.BoardID.ValueString()) ||
+			(!data.BoardName.IsNull() && b.Name == data.BoardName.ValueString()) {
+			source = &boards[i]
+		}
+		if b.Name == data.Name.ValueString() {
+			if data.SkipIfExists.ValueBool() {
+				resp.SendProgress(action.InvokeProgressEvent{
+					Message: fmt.Sprintf("board %q already exists, skipping", b.Name),
+				})
+				return
+			}
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Board Already Exists",
+				fmt.Sprintf("A board named %q already exists. Set skip_if_exists to ignore existing boards.", b.Name),
+			)
+			return
+		}
+	}
+
+	if source == nil {
+		resp.Diagnostics.AddError("Board Not Found", "The board to duplicate does not exist.")
+		return
+	}
+
+	isPublic := source.IsPublic
+	if !data.IsPublic.IsNull() {
+		isPublic = data.IsPublic.ValueBool()
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("duplicating board %q as %q", source.Name, data.Name.ValueString()),
+	})
+
+	created, err := a.client.DuplicateBoard(source.ID, data.Name.ValueString(), isPublic)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to duplicate board: %s", err))
+		return
+	}
+
+	if !data.UserPermissions.IsNull() {
+		permissions, diags := boardPermissionsFromMap(ctx, data.UserPermissions)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if err := a.client.SaveBoardUserPermissions(created.ID, permissions); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to save user permissions on board: %s", err))
+			return
+		}
+	}
+
+	if !data.GroupPermissions.IsNull() {
+		permissions, diags := boardPermissionsFromMap(ctx, data.GroupPermissions)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if err := a.client.SaveBoardGroupPermissions(created.ID, permissions); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to save group permissions on board: %s", err))
+			return
+		}
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("created board %q (%s)", created.Name, created.ID),
+	})
+}
+
+// boardPermissionsFromMap converts a principal ID => permission map into API permissions
+func boardPermissionsFromMap(ctx context.Context, m types.Map) ([]BoardPermission, diag.Diagnostics) {
+	var values map[string]string
+	diags := m.ElementsAs(ctx, &values, false)
+
+	permissions := make([]BoardPermission, 0, len(values))
+	for principalID, permission := range values {
+		permissions = append(permissions, BoardPermission{PrincipalID: principalID, Permission: permission})
+	}
+	sort.Slice(permissions, func(i, j int) bool { return permissions[i].PrincipalID < permissions[j].PrincipalID })
+
+	return permissions, diags
+}
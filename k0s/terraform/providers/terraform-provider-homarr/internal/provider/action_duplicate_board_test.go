@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDuplicateBoardActionSchema(t *testing.T) {
+	ctx := context.Background()
+
+	resp := &action.SchemaResponse{}
+	NewDuplicateBoardAction().Schema(ctx, action.SchemaRequest{}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("schema: %v", resp.Diagnostics)
+	}
+	if diags := resp.Schema.ValidateImplementation(ctx); diags.HasError() {
+		t.Fatalf("schema implementation: %v", diags)
+	}
+}
+
+func TestDuplicateBoardActionValidateConfig(t *testing.T) {
+	ctx := context.Background()
+
+	schemaResp := &action.SchemaResponse{}
+	NewDuplicateBoardAction().Schema(ctx, action.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	str := func(value interface{}) tftypes.Value { return tftypes.NewValue(tftypes.String, value) }
+
+	tests := []struct {
+		name      string
+		boardID   tftypes.Value
+		boardName tftypes.Value
+		wantError bool
+	}{
+		{name: "board_id", boardID: str("abc"), boardName: str(nil)},
+		{name: "board_name", boardID: str(nil), boardName: str("template")},
+		{name: "both", boardID: str("abc"), boardName: str("template"), wantError: true},
+		{name: "neither", boardID: str(nil), boardName: str(nil), wantError: true},
+		{name: "unknown", boardID: str(tftypes.UnknownValue), boardName: str(nil)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values := map[string]tftypes.Value{}
+			for name, attributeType := range objectType.AttributeTypes {
+				values[name] = tftypes.NewValue(attributeType, nil)
+			}
+			values["board_id"] = tt.boardID
+			values["board_name"] = tt.boardName
+			values["name"] = str("copy")
+
+			req := action.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+			}
+			resp := &action.ValidateConfigResponse{}
+			NewDuplicateBoardAction().(action.ActionWithValidateConfig).ValidateConfig(ctx, req, resp)
+
+			if resp.Diagnostics.HasError() != tt.wantError {
+				t.Errorf("expected error %t, got %v", tt.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestBoardPermissionValues(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		value     string
+		wantError bool
+	}{
+		{name: "view", value: "view"},
+		{name: "modify", value: "modify"},
+		{name: "full", value: "full"},
+		{name: "unknown level", value: "admin", wantError: true},
+		{name: "wrong case", value: "View", wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value := types.MapValueMust(types.StringType, map[string]attr.Value{"group-id": types.StringValue(tt.value)})
+			resp := &validator.MapResponse{}
+			mapValuesOneOf(boardPermissionValues...).ValidateMap(ctx, validator.MapRequest{
+				Path:        path.Root("group_permissions"),
+				ConfigValue: value,
+			}, resp)
+
+			if resp.Diagnostics.HasError() != tt.wantError {
+				t.Errorf("expected error %t, got %v", tt.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
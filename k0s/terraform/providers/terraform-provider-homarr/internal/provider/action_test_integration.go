@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ action.Action = &TestIntegrationAction{}
+var _ action.ActionWithConfigure = &TestIntegrationAction{}
+var _ action.ActionWithValidateConfig = &TestIntegrationAction{}
+
+func NewTestIntegrationAction() action.Action {
+	return &TestIntegrationAction{}
+}
+
+// TestIntegrationAction defines the action implementation.
+type TestIntegrationAction struct {
+	client *HomarrClient
+}
+
+// TestIntegrationActionModel describes the action data model.
+type TestIntegrationActionModel struct {
+	IntegrationID types.String `tfsdk:"integration_id"`
+	Kind          types.String `tfsdk:"kind"`
+	URL           types.String `tfsdk:"url"`
+	Secrets       types.Map    `tfsdk:"secrets"`
+}
+
+func (a *TestIntegrationAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_test_integration"
+}
+
+func (a *TestIntegrationAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Checks that Homarr can connect to a service with the given URL and credentials, without saving anything. " +
+			"Fails with a diagnostic on the offending attribute when the credentials are rejected, the certificate is invalid, " +
+			"or the service times out or can't be reached. Requires session_token authentication.",
+
+		Attributes: map[string]schema.Attribute{
+			"integration_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The ID of an existing integration. Its stored secrets are used for the secret kinds not given in `secrets`.",
+			},
+			"kind": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The type of integration (e.g., `sonarr`, `qBittorrent`).",
+			},
+			"url": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The URL of the service.",
+			},
+			"secrets": schema.MapAttribute{
+				Optional:            true,
+				WriteOnly:           true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The credentials to test, keyed by secret kind (e.g., `apiKey`, `username`, `password`). Accepts ephemeral values.",
+				Validators: []validator.Map{
+					mapKeysOneOf(IntegrationSecretKinds...),
+				},
+			},
+		},
+	}
+}
+
+func (a *TestIntegrationAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var kind types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("kind"), &kind)...)
+	if resp.Diagnostics.HasError() || kind.IsNull() || kind.IsUnknown() {
+		return
+	}
+
+	if _, ok := findIntegrationDefinition(kind.ValueString()); !ok {
+		resp.Diagnostics.Append(unknownIntegrationKindWarning(path.Root("kind"), kind.ValueString())...)
+	}
+}
+
+func (a *TestIntegrationAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*HomarrClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *HomarrClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = client
+}
+
+func (a *TestIntegrationAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data TestIntegrationActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if a.client.SessionToken == "" {
+		resp.Diagnostics.AddError("Missing Session Token", "Integrations require session_token authentication. Please configure session_token in the provider.")
+		return
+	}
+
+	values := map[string]string{}
+	if !data.Secrets.IsNull() {
+		resp.Diagnostics.Append(data.Secrets.ElementsAs(ctx, &values, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	secrets := secretsFromMap(values)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("testing connection to %s integration at %s", data.Kind.ValueString(), data.URL.ValueString()),
+	})
+
+	err := a.client.TestIntegrationConnection(TestIntegrationInput{
+		ID:      data.IntegrationID.ValueStringPointer(),
+		Kind:    data.Kind.ValueString(),
+		URL:     data.URL.ValueString(),
+		Secrets: secrets,
+	})
+	if err != nil {
+		resp.Diagnostics.Append(integrationConnectionDiagnostics(err, path.Root("url"), path.Root("secrets"))...)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: "connection successful",
+	})
+}
+
+// integrationConnectionDiagnostics turns a failed connection test into an error on the attribute most likely at fault
+func integrationConnectionDiagnostics(err error, urlPath, secretsPath path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var connErr *IntegrationConnectionError
+	if !errors.As(err, &connErr) {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to test integration connection: %s", err))
+		return diags
+	}
+
+	switch connErr.Type {
+	case IntegrationErrorAuthorization:
+		diags.AddAttributeError(secretsPath, "Integration Credentials Rejected",
+			fmt.Sprintf("The service rejected the credentials: %s", connErr.Message))
+	case IntegrationErrorCertificate:
+		diags.AddAttributeError(urlPath, "Integration Certificate Invalid",
+			fmt.Sprintf("The TLS certificate of the service isn't trusted by Homarr: %s. "+
+				"Add the certificate in Homarr's certificate settings or use a trusted certificate.", connErr.Message))
+	case IntegrationErrorRequest:
+		summary := "Integration Unreachable"
+		if connErr.Data.Reason == "timeout" {
+			summary = "Integration Timed Out"
+		}
+		diags.AddAttributeError(urlPath, summary,
+			fmt.Sprintf("Homarr couldn't reach the service: %s", connErr.Message))
+	case IntegrationErrorStatusCode:
+		diags.AddAttributeError(urlPath, "Unexpected Integration Response",
+			fmt.Sprintf("The service responded with status %d: %s. Check that the URL points to the service's API.", connErr.Data.StatusCode, connErr.Message))
+	default:
+		diags.AddAttributeError(urlPath, "Integration Connection Failed",
+			fmt.Sprintf("Homarr couldn't connect to the service (%s): %s", connErr.Type, connErr.Message))
+	}
+
+	return diags
+}
@@ -2,10 +2,18 @@ package provider
 
 import (
 	"bytes"
+	"crypto/rand"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"mime/multipart"
 	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
 )
 
 // HomarrClient is the API client for Homarr
@@ -63,6 +71,22 @@ func (c *HomarrClient) doRequest(method, path string, body interface{}) ([]byte,
 	return respBody, nil
 }
 
+// NotFoundError is returned when a requested object does not exist
+type NotFoundError struct {
+	Kind string
+	ID   string
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("%s not found: %s", e.Kind, e.ID)
+}
+
+// IsNotFound reports whether err is a NotFoundError
+func IsNotFound(err error) bool {
+	var notFound *NotFoundError
+	return errors.As(err, &notFound)
+}
+
 // TRPCResponse wraps the tRPC response format
 type TRPCResponse struct {
 	Result struct {
@@ -122,9 +146,10 @@ func (c *HomarrClient) doTRPCQueryWithInput(procedure string, input interface{})
 		return nil, fmt.Errorf("failed to marshal input: %w", err)
 	}
 
-	url := c.BaseURL + "/api/trpc/" + procedure + "?input=" + string(inputJSON)
+	// Inputs may contain spaces and other characters that aren't valid in a query string
+	requestURL := c.BaseURL + "/api/trpc/" + procedure + "?input=" + url.QueryEscape(string(inputJSON))
 
-	req, err := http.NewRequest("GET", url, nil)
+	req, err := http.NewRequest("GET", requestURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
@@ -158,14 +183,32 @@ func (c *HomarrClient) doTRPCQueryWithInput(procedure string, input interface{})
 // TRPCInput wraps input for tRPC mutations
 type TRPCInput struct {
 	JSON interface{} `json:"json"`
+	Meta *TRPCMeta   `json:"meta,omitempty"`
+}
+
+// TRPCMeta tells superjson which input values to decode into richer types than JSON supports
+type TRPCMeta struct {
+	Values map[string][]string `json:"values"`
+}
+
+// trpcDates returns superjson metadata marking the given top-level input fields as dates
+func trpcDates(fields ...string) *TRPCMeta {
+	meta := &TRPCMeta{Values: make(map[string][]string, len(fields))}
+	for _, field := range fields {
+		meta.Values[field] = []string{"Date"}
+	}
+	return meta
 }
 
 // doTRPCMutation performs a tRPC POST mutation with session token authentication
 func (c *HomarrClient) doTRPCMutation(procedure string, input interface{}) (json.RawMessage, error) {
 	var reqBody io.Reader
 	if input != nil {
-		// tRPC expects input wrapped in {"json": ...}
-		wrapped := TRPCInput{JSON: input}
+		// tRPC expects input wrapped in {"json": ...}, unless it is already wrapped with metadata
+		wrapped, ok := input.(TRPCInput)
+		if !ok {
+			wrapped = TRPCInput{JSON: input}
+		}
 		jsonBody, err := json.Marshal(wrapped)
 		if err != nil {
 			return nil, fmt.Errorf("failed to marshal input: %w", err)
@@ -204,6 +247,68 @@ func (c *HomarrClient) doTRPCMutation(procedure string, input interface{}) (json
 	return trpcResp.Result.Data.JSON, nil
 }
 
+// TRPCFormFile is a file uploaded with a tRPC form data mutation
+type TRPCFormFile struct {
+	Name    string
+	Content []byte
+}
+
+// doTRPCFormMutation performs a tRPC POST mutation with multipart form data input
+func (c *HomarrClient) doTRPCFormMutation(procedure string, fields map[string]string, files map[string]TRPCFormFile) (json.RawMessage, error) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+
+	for name, value := range fields {
+		if err := writer.WriteField(name, value); err != nil {
+			return nil, fmt.Errorf("failed to write form field %s: %w", name, err)
+		}
+	}
+
+	for name, file := range files {
+		part, err := writer.CreateFormFile(name, file.Name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create form file %s: %w", name, err)
+		}
+		if _, err := part.Write(file.Content); err != nil {
+			return nil, fmt.Errorf("failed to write form file %s: %w", name, err)
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("failed to finalize form data: %w", err)
+	}
+
+	req, err := http.NewRequest("POST", c.BaseURL+"/api/trpc/"+procedure, &body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Cookie", "authjs.session-token="+c.SessionToken)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var trpcResp TRPCResponse
+	if err := json.Unmarshal(respBody, &trpcResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal tRPC response: %w", err)
+	}
+
+	if trpcResp.Error != nil {
+		return nil, fmt.Errorf("tRPC error: %s", trpcResp.Error.JSON.Message)
+	}
+
+	return trpcResp.Result.Data.JSON, nil
+}
+
 // =============================================================================
 // App (REST API)
 // =============================================================================
@@ -233,6 +338,21 @@ func (c *HomarrClient) GetApps() ([]App, error) {
 	return apps, nil
 }
 
+// GetAppsTRPC retrieves all apps via tRPC, for callers authenticated with a session token only
+func (c *HomarrClient) GetAppsTRPC() ([]App, error) {
+	resp, err := c.doTRPCQuery("app.all", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var apps []App
+	if err := json.Unmarshal(resp, &apps); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal apps: %w", err)
+	}
+
+	return apps, nil
+}
+
 // GetApp retrieves a single app by ID
 func (c *HomarrClient) GetApp(id string) (*App, error) {
 	resp, err := c.doRequest("GET", "/api/apps/"+id, nil)
@@ -284,6 +404,19 @@ func (c *HomarrClient) DeleteApp(id string) error {
 // Group (tRPC)
 // =============================================================================
 
+// Built-in groups, which Homarr doesn't allow to be renamed or deleted
+const (
+	// EveryoneGroupName is the name of the group every user belongs to
+	EveryoneGroupName = "everyone"
+	// CredentialsAdminGroupName is the name of the admin group created during onboarding
+	CredentialsAdminGroupName = "credentials-admin"
+)
+
+// IsReservedGroup reports whether the group name belongs to a built-in group
+func IsReservedGroup(name string) bool {
+	return name == EveryoneGroupName || name == CredentialsAdminGroupName
+}
+
 // Group represents a Homarr group
 type Group struct {
 	ID       string        `json:"id"`
@@ -294,9 +427,10 @@ type Group struct {
 
 // GroupMember represents a member in a group
 type GroupMember struct {
-	ID    string `json:"id"`
-	Name  string `json:"name"`
-	Email string `json:"email"`
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Email    string `json:"email"`
+	Provider string `json:"provider,omitempty"`
 }
 
 // GetGroups retrieves all groups via tRPC
@@ -327,7 +461,139 @@ func (c *HomarrClient) GetGroup(id string) (*Group, error) {
 		}
 	}
 
-	return nil, fmt.Errorf("group not found: %s", id)
+	return nil, &NotFoundError{Kind: "group", ID: id}
+}
+
+// GetGroupByName retrieves a single group by name
+func (c *HomarrClient) GetGroupByName(name string) (*Group, error) {
+	groups, err := c.GetGroups()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, g := range groups {
+		if g.Name == name {
+			return &g, nil
+		}
+	}
+
+	return nil, &NotFoundError{Kind: "group", ID: name}
+}
+
+// GroupDetail represents a group including its members, global permissions and home boards
+type GroupDetail struct {
+	ID                string        `json:"id"`
+	Name              string        `json:"name"`
+	HomeBoardID       *string       `json:"homeBoardId"`
+	MobileHomeBoardID *string       `json:"mobileHomeBoardId"`
+	Members           []GroupMember `json:"members"`
+	Permissions       []string      `json:"permissions"`
+}
+
+// GetGroupDetail retrieves a group with its members, including the provider they sign in with, and permissions
+func (c *HomarrClient) GetGroupDetail(groupID string) (*GroupDetail, error) {
+	// group.getById fails with a generic error for unknown IDs, so check the list first
+	if _, err := c.GetGroup(groupID); err != nil {
+		return nil, err
+	}
+
+	input := map[string]string{"id": groupID}
+	resp, err := c.doTRPCQueryWithInput("group.getById", input)
+	if err != nil {
+		return nil, err
+	}
+
+	var group GroupDetail
+	if err := json.Unmarshal(resp, &group); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal group: %w", err)
+	}
+
+	return &group, nil
+}
+
+// GetGroupMembers retrieves the members of a group, including the provider they sign in with
+func (c *HomarrClient) GetGroupMembers(groupID string) ([]GroupMember, error) {
+	group, err := c.GetGroupDetail(groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	return group.Members, nil
+}
+
+// GroupPermissions are the global permissions a group can grant
+var GroupPermissions = []string{
+	"admin",
+	"app-create", "app-use-all", "app-modify-all", "app-full-all",
+	"board-create", "board-view-all", "board-modify-all", "board-full-all",
+	"integration-create", "integration-use-all", "integration-interact-all", "integration-full-all",
+	"media-upload", "media-view-all", "media-full-all",
+	"other-view-logs",
+	"search-engine-create", "search-engine-modify-all", "search-engine-full-all",
+}
+
+// SaveGroupPermissionsInput represents the input for saving the permissions of a group
+type SaveGroupPermissionsInput struct {
+	GroupID     string   `json:"groupId"`
+	Permissions []string `json:"permissions"`
+}
+
+// SaveGroupPermissions replaces the global permissions of a group via tRPC
+func (c *HomarrClient) SaveGroupPermissions(groupID string, permissions []string) error {
+	input := SaveGroupPermissionsInput{GroupID: groupID, Permissions: permissions}
+	_, err := c.doTRPCMutation("group.savePermissions", input)
+	return err
+}
+
+// GroupSettings represents the boards shown to group members after sign-in; nil fields are left unchanged
+type GroupSettings struct {
+	HomeBoardID       *string `json:"homeBoardId,omitempty"`
+	MobileHomeBoardID *string `json:"mobileHomeBoardId,omitempty"`
+}
+
+// SaveGroupSettingsInput represents the input for saving the settings of a group
+type SaveGroupSettingsInput struct {
+	ID       string        `json:"id"`
+	Settings GroupSettings `json:"settings"`
+}
+
+// SaveGroupSettings sets the home boards of a group via tRPC
+func (c *HomarrClient) SaveGroupSettings(groupID string, settings GroupSettings) error {
+	input := SaveGroupSettingsInput{ID: groupID, Settings: settings}
+	_, err := c.doTRPCMutation("group.savePartialSettings", input)
+	return err
+}
+
+// SaveGroupPositionsInput represents the input for ordering groups
+type SaveGroupPositionsInput struct {
+	Positions []string `json:"positions"`
+}
+
+// SaveGroupPositions orders groups by precedence, first group first, via tRPC
+func (c *HomarrClient) SaveGroupPositions(groupIDs []string) error {
+	input := SaveGroupPositionsInput{Positions: groupIDs}
+	_, err := c.doTRPCMutation("group.savePositions", input)
+	return err
+}
+
+// GroupMemberInput represents the input for adding or removing a group member
+type GroupMemberInput struct {
+	GroupID string `json:"groupId"`
+	UserID  string `json:"userId"`
+}
+
+// AddGroupMember adds a user to a group via tRPC
+func (c *HomarrClient) AddGroupMember(groupID, userID string) error {
+	input := GroupMemberInput{GroupID: groupID, UserID: userID}
+	_, err := c.doTRPCMutation("group.addMember", input)
+	return err
+}
+
+// RemoveGroupMember removes a user from a group via tRPC
+func (c *HomarrClient) RemoveGroupMember(groupID, userID string) error {
+	input := GroupMemberInput{GroupID: groupID, UserID: userID}
+	_, err := c.doTRPCMutation("group.removeMember", input)
+	return err
 }
 
 // CreateGroupInput represents the input for creating a group
@@ -383,128 +649,524 @@ func (c *HomarrClient) DeleteGroup(id string) error {
 }
 
 // =============================================================================
-// Server Settings (tRPC)
+// User (tRPC)
 // =============================================================================
 
-// ServerSettings represents all Homarr server settings
-type ServerSettings struct {
-	Analytics           AnalyticsSettings           `json:"analytics"`
-	CrawlingAndIndexing CrawlingAndIndexingSettings `json:"crawlingAndIndexing"`
-	Board               BoardSettings               `json:"board"`
-	Appearance          AppearanceSettings          `json:"appearance"`
-	Culture             CultureSettings             `json:"culture"`
-	Search              SearchSettings              `json:"search"`
-}
+// User providers as reported by Homarr
+const (
+	UserProviderCredentials = "credentials"
+	UserProviderOIDC        = "oidc"
+	UserProviderLDAP        = "ldap"
+)
 
-type AnalyticsSettings struct {
-	EnableGeneral         bool `json:"enableGeneral"`
-	EnableWidgetData      bool `json:"enableWidgetData"`
-	EnableIntegrationData bool `json:"enableIntegrationData"`
-	EnableUserData        bool `json:"enableUserData"`
+// User represents a Homarr user
+type User struct {
+	ID       string  `json:"id"`
+	Name     string  `json:"name"`
+	Email    *string `json:"email"`
+	Image    *string `json:"image"`
+	Provider string  `json:"provider"`
 }
 
-type CrawlingAndIndexingSettings struct {
-	NoIndex              bool `json:"noIndex"`
-	NoFollow             bool `json:"noFollow"`
-	NoTranslate          bool `json:"noTranslate"`
-	NoSiteLinksSearchBox bool `json:"noSiteLinksSearchBox"`
-}
+// GetUsers retrieves all users via tRPC
+func (c *HomarrClient) GetUsers() ([]User, error) {
+	resp, err := c.doTRPCQuery("user.getAll", nil)
+	if err != nil {
+		return nil, err
+	}
 
-type BoardSettings struct {
-	HomeBoardID           *string `json:"homeBoardId"`
-	MobileHomeBoardID     *string `json:"mobileHomeBoardId"`
-	EnableStatusByDefault bool    `json:"enableStatusByDefault"`
-	ForceDisableStatus    bool    `json:"forceDisableStatus"`
-}
+	var users []User
+	if err := json.Unmarshal(resp, &users); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal users: %w", err)
+	}
 
-type AppearanceSettings struct {
-	DefaultColorScheme string `json:"defaultColorScheme"`
+	return users, nil
 }
 
-type CultureSettings struct {
-	DefaultLocale string `json:"defaultLocale"`
-}
+// GetUser retrieves a single user by ID, including the provider the user signs in with
+func (c *HomarrClient) GetUser(id string) (*User, error) {
+	// user.getById fails with a generic error for unknown IDs, so check the list first
+	users, err := c.GetUsers()
+	if err != nil {
+		return nil, err
+	}
 
-type SearchSettings struct {
-	DefaultSearchEngineID string `json:"defaultSearchEngineId"`
+	found := false
+	for _, u := range users {
+		if u.ID == id {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, &NotFoundError{Kind: "user", ID: id}
+	}
+
+	input := map[string]string{"userId": id}
+	resp, err := c.doTRPCQueryWithInput("user.getById", input)
+	if err != nil {
+		return nil, err
+	}
+
+	var user User
+	if err := json.Unmarshal(resp, &user); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal user: %w", err)
+	}
+
+	return &user, nil
 }
 
-// GetServerSettings retrieves all server settings via tRPC
-func (c *HomarrClient) GetServerSettings() (*ServerSettings, error) {
-	resp, err := c.doTRPCQuery("serverSettings.getAll", nil)
+// GetUserByName retrieves a single user by username
+func (c *HomarrClient) GetUserByName(name string) (*User, error) {
+	users, err := c.GetUsers()
 	if err != nil {
 		return nil, err
 	}
 
-	var settings ServerSettings
-	if err := json.Unmarshal(resp, &settings); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal server settings: %w", err)
+	for _, u := range users {
+		if u.Name == name {
+			return c.GetUser(u.ID)
+		}
 	}
 
-	return &settings, nil
+	return nil, &NotFoundError{Kind: "user", ID: name}
 }
 
-// SaveServerSettings saves server settings via tRPC
-func (c *HomarrClient) SaveServerSettings(settings *ServerSettings) error {
-	_, err := c.doTRPCMutation("serverSettings.saveSettings", settings)
+// CreateUserInput represents the input for creating a credentials user
+type CreateUserInput struct {
+	Username        string   `json:"username"`
+	Email           string   `json:"email"`
+	Password        string   `json:"password"`
+	ConfirmPassword string   `json:"confirmPassword"`
+	GroupIDs        []string `json:"groupIds"`
+}
+
+// CreateUser creates a new credentials user via tRPC
+func (c *HomarrClient) CreateUser(username, email, password string) (*User, error) {
+	input := CreateUserInput{
+		Username:        username,
+		Email:           email,
+		Password:        password,
+		ConfirmPassword: password,
+		GroupIDs:        []string{},
+	}
+	if _, err := c.doTRPCMutation("user.create", input); err != nil {
+		return nil, err
+	}
+
+	// user.create doesn't return the new user
+	return c.GetUserByName(username)
+}
+
+// EditUserProfileInput represents the input for editing a user profile
+type EditUserProfileInput struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+// EditUserProfile updates the username and email of a user via tRPC
+func (c *HomarrClient) EditUserProfile(id, name, email string) error {
+	input := EditUserProfileInput{ID: id, Name: name, Email: email}
+	_, err := c.doTRPCMutation("user.editProfile", input)
 	return err
 }
 
-// =============================================================================
-// Integration (tRPC)
-// =============================================================================
+// ChangeUserPasswordInput represents the input for changing a password
+type ChangeUserPasswordInput struct {
+	UserID           string `json:"userId"`
+	PreviousPassword string `json:"previousPassword"`
+	Password         string `json:"password"`
+	ConfirmPassword  string `json:"confirmPassword"`
+}
 
-// Integration represents a Homarr integration
-type Integration struct {
-	ID      string              `json:"id"`
-	Name    string              `json:"name"`
-	Kind    string              `json:"kind"`
-	URL     string              `json:"url"`
-	Secrets []IntegrationSecret `json:"secrets,omitempty"`
+// ChangeUserPassword sets a new password for a credentials user via tRPC
+// (admins changing another user's password don't need the previous one)
+func (c *HomarrClient) ChangeUserPassword(id, password string) error {
+	input := ChangeUserPasswordInput{UserID: id, Password: password, ConfirmPassword: password}
+	_, err := c.doTRPCMutation("user.changePassword", input)
+	return err
 }
 
-// IntegrationSecret represents a secret for an integration
-type IntegrationSecret struct {
-	Kind  string `json:"kind"`
-	Value string `json:"value,omitempty"`
+// SetUserProfileImage sets or, with nil, removes the avatar of a user via tRPC
+func (c *HomarrClient) SetUserProfileImage(id string, image *string) error {
+	input := map[string]interface{}{"userId": id, "image": image}
+	_, err := c.doTRPCMutation("user.setProfileImage", input)
+	return err
 }
 
-// CreateIntegrationInput represents the input for creating an integration
-type CreateIntegrationInput struct {
-	Name                        string              `json:"name"`
-	Kind                        string              `json:"kind"`
-	URL                         string              `json:"url"`
-	Secrets                     []IntegrationSecret `json:"secrets"`
-	AttemptSearchEngineCreation bool                `json:"attemptSearchEngineCreation"`
+// DeleteUser deletes a user via tRPC
+func (c *HomarrClient) DeleteUser(id string) error {
+	input := map[string]string{"userId": id}
+	_, err := c.doTRPCMutation("user.delete", input)
+	return err
 }
 
-// UpdateIntegrationInput represents the input for updating an integration
-type UpdateIntegrationInput struct {
-	ID      string              `json:"id"`
-	Name    string              `json:"name"`
-	URL     string              `json:"url"`
-	Secrets []IntegrationSecret `json:"secrets"`
-	AppID   *string             `json:"appId"`
+// =============================================================================
+// Invite (tRPC)
+// =============================================================================
+
+// Invite represents a registration invite; the token is only returned on creation
+type Invite struct {
+	ID             string    `json:"id"`
+	ExpirationDate time.Time `json:"expirationDate"`
+	Creator        *struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"creator,omitempty"`
 }
 
-// GetIntegrations retrieves all integrations via tRPC
-func (c *HomarrClient) GetIntegrations() ([]Integration, error) {
-	resp, err := c.doTRPCQuery("integration.all", nil)
+// CreatedInvite represents the response of creating an invite
+type CreatedInvite struct {
+	ID    string `json:"id"`
+	Token string `json:"token"`
+}
+
+// CreateInviteInput represents the input for creating an invite
+type CreateInviteInput struct {
+	ExpirationDate time.Time `json:"expirationDate"`
+}
+
+// GetInvites retrieves all invites via tRPC
+func (c *HomarrClient) GetInvites() ([]Invite, error) {
+	resp, err := c.doTRPCQuery("invite.getAll", nil)
 	if err != nil {
 		return nil, err
 	}
 
-	var integrations []Integration
-	if err := json.Unmarshal(resp, &integrations); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal integrations: %w", err)
+	var invites []Invite
+	if err := json.Unmarshal(resp, &invites); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal invites: %w", err)
 	}
 
-	return integrations, nil
+	return invites, nil
 }
 
-// GetIntegrationByID retrieves a single integration by ID via tRPC query
-func (c *HomarrClient) GetIntegrationByID(id string) (*Integration, error) {
-	input := map[string]string{"id": id}
+// GetInvite retrieves a single invite by ID
+func (c *HomarrClient) GetInvite(id string) (*Invite, error) {
+	invites, err := c.GetInvites()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, i := range invites {
+		if i.ID == id {
+			return &i, nil
+		}
+	}
+
+	return nil, &NotFoundError{Kind: "invite", ID: id}
+}
+
+// CreateInvite creates a new invite via tRPC
+func (c *HomarrClient) CreateInvite(expirationDate time.Time) (*CreatedInvite, error) {
+	input := TRPCInput{JSON: CreateInviteInput{ExpirationDate: expirationDate.UTC()}, Meta: trpcDates("expirationDate")}
+	resp, err := c.doTRPCMutation("invite.createInvite", input)
+	if err != nil {
+		return nil, err
+	}
+
+	var invite CreatedInvite
+	if err := json.Unmarshal(resp, &invite); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal created invite: %w", err)
+	}
+
+	return &invite, nil
+}
+
+// DeleteInvite deletes an invite via tRPC
+func (c *HomarrClient) DeleteInvite(id string) error {
+	input := map[string]string{"id": id}
+	_, err := c.doTRPCMutation("invite.deleteInvite", input)
+	return err
+}
+
+// InviteURL returns the registration link of an invite
+func (c *HomarrClient) InviteURL(id, token string) string {
+	return strings.TrimSuffix(c.BaseURL, "/") + "/auth/invite/" + id + "?token=" + url.QueryEscape(token)
+}
+
+// =============================================================================
+// API Key (tRPC)
+// =============================================================================
+
+// APIKeyInfo represents an API key; the secret is only returned on creation
+type APIKeyInfo struct {
+	ID   string `json:"id"`
+	User struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"user"`
+}
+
+// CreatedAPIKey represents the response of creating an API key
+type CreatedAPIKey struct {
+	// APIKey is the full key, formatted as <id>.<token>
+	APIKey string `json:"apiKey"`
+}
+
+// ID returns the ID part of the created key
+func (k *CreatedAPIKey) ID() string {
+	id, _, _ := strings.Cut(k.APIKey, ".")
+	return id
+}
+
+// GetAPIKeys retrieves all API keys via tRPC
+func (c *HomarrClient) GetAPIKeys() ([]APIKeyInfo, error) {
+	resp, err := c.doTRPCQuery("apiKeys.getAll", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []APIKeyInfo
+	if err := json.Unmarshal(resp, &keys); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal API keys: %w", err)
+	}
+
+	return keys, nil
+}
+
+// GetAPIKey retrieves a single API key by ID
+func (c *HomarrClient) GetAPIKey(id string) (*APIKeyInfo, error) {
+	keys, err := c.GetAPIKeys()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, k := range keys {
+		if k.ID == id {
+			return &k, nil
+		}
+	}
+
+	return nil, &NotFoundError{Kind: "API key", ID: id}
+}
+
+// CreateAPIKey creates a new API key for the session user via tRPC
+func (c *HomarrClient) CreateAPIKey() (*CreatedAPIKey, error) {
+	resp, err := c.doTRPCMutation("apiKeys.create", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var key CreatedAPIKey
+	if err := json.Unmarshal(resp, &key); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal created API key: %w", err)
+	}
+	if key.ID() == "" {
+		return nil, fmt.Errorf("unexpected API key format in response")
+	}
+
+	return &key, nil
+}
+
+// DeleteAPIKey deletes an API key via tRPC
+func (c *HomarrClient) DeleteAPIKey(id string) error {
+	input := map[string]string{"apiKeyId": id}
+	_, err := c.doTRPCMutation("apiKeys.delete", input)
+	return err
+}
+
+// =============================================================================
+// Server Settings (tRPC)
+// =============================================================================
+
+// ServerSettings represents all Homarr server settings
+type ServerSettings struct {
+	Analytics           AnalyticsSettings           `json:"analytics"`
+	CrawlingAndIndexing CrawlingAndIndexingSettings `json:"crawlingAndIndexing"`
+	Board               BoardSettings               `json:"board"`
+	Appearance          AppearanceSettings          `json:"appearance"`
+	Culture             CultureSettings             `json:"culture"`
+	Search              SearchSettings              `json:"search"`
+}
+
+type AnalyticsSettings struct {
+	EnableGeneral         bool `json:"enableGeneral"`
+	EnableWidgetData      bool `json:"enableWidgetData"`
+	EnableIntegrationData bool `json:"enableIntegrationData"`
+	EnableUserData        bool `json:"enableUserData"`
+}
+
+type CrawlingAndIndexingSettings struct {
+	NoIndex              bool `json:"noIndex"`
+	NoFollow             bool `json:"noFollow"`
+	NoTranslate          bool `json:"noTranslate"`
+	NoSiteLinksSearchBox bool `json:"noSiteLinksSearchBox"`
+}
+
+type BoardSettings struct {
+	HomeBoardID           *string `json:"homeBoardId"`
+	MobileHomeBoardID     *string `json:"mobileHomeBoardId"`
+	EnableStatusByDefault bool    `json:"enableStatusByDefault"`
+	ForceDisableStatus    bool    `json:"forceDisableStatus"`
+}
+
+type AppearanceSettings struct {
+	DefaultColorScheme string `json:"defaultColorScheme"`
+}
+
+type CultureSettings struct {
+	DefaultLocale string `json:"defaultLocale"`
+}
+
+type SearchSettings struct {
+	DefaultSearchEngineID *string `json:"defaultSearchEngineId"`
+}
+
+// Server settings keys accepted by serverSettings.saveSettings
+const (
+	ServerSettingsKeyAnalytics           = "analytics"
+	ServerSettingsKeyCrawlingAndIndexing = "crawlingAndIndexing"
+	ServerSettingsKeyBoard               = "board"
+	ServerSettingsKeyAppearance          = "appearance"
+	ServerSettingsKeyCulture             = "culture"
+	ServerSettingsKeySearch              = "search"
+)
+
+// GetServerSettings retrieves all server settings via tRPC
+func (c *HomarrClient) GetServerSettings() (*ServerSettings, error) {
+	resp, err := c.doTRPCQuery("serverSettings.getAll", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var settings ServerSettings
+	if err := json.Unmarshal(resp, &settings); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal server settings: %w", err)
+	}
+
+	return &settings, nil
+}
+
+// SaveServerSettingsInput represents the input for saving one settings section
+type SaveServerSettingsInput struct {
+	SettingsKey string      `json:"settingsKey"`
+	Value       interface{} `json:"value"`
+}
+
+// SaveServerSettingsSection saves a single server settings section via tRPC
+func (c *HomarrClient) SaveServerSettingsSection(key string, value interface{}) error {
+	input := SaveServerSettingsInput{SettingsKey: key, Value: value}
+	_, err := c.doTRPCMutation("serverSettings.saveSettings", input)
+	return err
+}
+
+// SaveServerSettings saves all server settings sections via tRPC
+func (c *HomarrClient) SaveServerSettings(settings *ServerSettings) error {
+	sections := []struct {
+		key   string
+		value interface{}
+	}{
+		{ServerSettingsKeyAnalytics, settings.Analytics},
+		{ServerSettingsKeyCrawlingAndIndexing, settings.CrawlingAndIndexing},
+		{ServerSettingsKeyBoard, settings.Board},
+		{ServerSettingsKeyAppearance, settings.Appearance},
+		{ServerSettingsKeyCulture, settings.Culture},
+		{ServerSettingsKeySearch, settings.Search},
+	}
+
+	for _, section := range sections {
+		if err := c.SaveServerSettingsSection(section.key, section.value); err != nil {
+			return fmt.Errorf("failed to save %s settings: %w", section.key, err)
+		}
+	}
+
+	return nil
+}
+
+// =============================================================================
+// Integration (tRPC)
+// =============================================================================
+
+// Integration represents a Homarr integration
+type Integration struct {
+	ID      string              `json:"id"`
+	Name    string              `json:"name"`
+	Kind    string              `json:"kind"`
+	URL     string              `json:"url"`
+	Secrets []IntegrationSecret `json:"secrets,omitempty"`
+	// AppID is the app the integration belongs to, if any
+	AppID *string `json:"appId"`
+}
+
+// IntegrationSecret represents a secret for an integration
+type IntegrationSecret struct {
+	Kind  string `json:"kind"`
+	Value string `json:"value,omitempty"`
+	// UpdatedAt is only returned when reading an integration
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+}
+
+// Secret kinds accepted by Homarr integrations
+const (
+	IntegrationSecretAPIKey               = "apiKey"
+	IntegrationSecretUsername             = "username"
+	IntegrationSecretPassword             = "password"
+	IntegrationSecretTokenID              = "tokenId"
+	IntegrationSecretRealm                = "realm"
+	IntegrationSecretPersonalAccessToken  = "personalAccessToken"
+	IntegrationSecretTopic                = "topic"
+	IntegrationSecretOPNsenseAPIKey       = "opnsenseApiKey"
+	IntegrationSecretOPNsenseAPISecret    = "opnsenseApiSecret"
+	IntegrationSecretPrivateKey           = "privateKey"
+	IntegrationSecretGithubAppID          = "githubAppId"
+	IntegrationSecretGithubInstallationID = "githubInstallationId"
+)
+
+// IntegrationSecretKinds lists all secret kinds accepted by Homarr integrations
+var IntegrationSecretKinds = []string{
+	IntegrationSecretAPIKey,
+	IntegrationSecretUsername,
+	IntegrationSecretPassword,
+	IntegrationSecretTokenID,
+	IntegrationSecretRealm,
+	IntegrationSecretPersonalAccessToken,
+	IntegrationSecretTopic,
+	IntegrationSecretOPNsenseAPIKey,
+	IntegrationSecretOPNsenseAPISecret,
+	IntegrationSecretPrivateKey,
+	IntegrationSecretGithubAppID,
+	IntegrationSecretGithubInstallationID,
+}
+
+// CreateIntegrationInput represents the input for creating an integration
+type CreateIntegrationInput struct {
+	Name                        string              `json:"name"`
+	Kind                        string              `json:"kind"`
+	URL                         string              `json:"url"`
+	Secrets                     []IntegrationSecret `json:"secrets"`
+	AttemptSearchEngineCreation bool                `json:"attemptSearchEngineCreation"`
+	AppID                       *string             `json:"appId,omitempty"`
+}
+
+// UpdateIntegrationInput represents the input for updating an integration
+type UpdateIntegrationInput struct {
+	ID      string              `json:"id"`
+	Name    string              `json:"name"`
+	URL     string              `json:"url"`
+	Secrets []IntegrationSecret `json:"secrets"`
+	AppID   *string             `json:"appId"`
+}
+
+// GetIntegrations retrieves all integrations via tRPC
+func (c *HomarrClient) GetIntegrations() ([]Integration, error) {
+	resp, err := c.doTRPCQuery("integration.all", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var integrations []Integration
+	if err := json.Unmarshal(resp, &integrations); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal integrations: %w", err)
+	}
+
+	return integrations, nil
+}
+
+// GetIntegrationByID retrieves a single integration by ID via tRPC query
+func (c *HomarrClient) GetIntegrationByID(id string) (*Integration, error) {
+	input := map[string]string{"id": id}
 	resp, err := c.doTRPCQueryWithInput("integration.byId", input)
 	if err != nil {
 		return nil, err
@@ -518,13 +1180,48 @@ func (c *HomarrClient) GetIntegrationByID(id string) (*Integration, error) {
 	return &integration, nil
 }
 
+// Types of IntegrationConnectionError
+const (
+	IntegrationErrorAuthorization = "authorization"
+	IntegrationErrorCertificate   = "certificate"
+	IntegrationErrorStatusCode    = "statusCode"
+	IntegrationErrorRequest       = "request"
+	IntegrationErrorParse         = "parse"
+)
+
+// IntegrationConnectionError describes why Homarr couldn't connect to an integration
+type IntegrationConnectionError struct {
+	Type    string `json:"type"`
+	Name    string `json:"name"`
+	Message string `json:"message"`
+	Data    struct {
+		// Reason details request errors, such as timeout or connectionRefused
+		Reason     string `json:"reason,omitempty"`
+		StatusCode int    `json:"statusCode,omitempty"`
+	} `json:"data"`
+}
+
+func (e *IntegrationConnectionError) Error() string {
+	return fmt.Sprintf("integration error: %s", e.Message)
+}
+
 // IntegrationErrorResponse represents an error returned in the response data
 type IntegrationErrorResponse struct {
-	Error *struct {
-		Type    string `json:"type"`
-		Name    string `json:"name"`
-		Message string `json:"message"`
-	} `json:"error,omitempty"`
+	Error *IntegrationConnectionError `json:"error,omitempty"`
+}
+
+// connectionError extracts the connection error from an integration mutation response, if any
+func connectionError(resp json.RawMessage) error {
+	if len(resp) == 0 || string(resp) == "null" {
+		return nil
+	}
+
+	var errResp IntegrationErrorResponse
+	if err := json.Unmarshal(resp, &errResp); err == nil && errResp.Error != nil {
+		return errResp.Error
+	}
+
+	return nil
 }
 
 // CreateIntegration creates a new integration via tRPC
@@ -535,11 +1232,8 @@ func (c *HomarrClient) CreateIntegration(input CreateIntegrationInput) (*Integra
 	}
 
 	// Check if the response contains an error (connectivity issues, etc.)
-	if resp != nil && len(resp) > 0 && string(resp) != "null" {
-		var errResp IntegrationErrorResponse
-		if err := json.Unmarshal(resp, &errResp); err == nil && errResp.Error != nil {
-			return nil, fmt.Errorf("integration error: %s", errResp.Error.Message)
-		}
+	if err := connectionError(resp); err != nil {
+		return nil, err
 	}
 
 	// The create response doesn't return the full object, so we need to find it
@@ -564,6 +1258,27 @@ func (c *HomarrClient) UpdateIntegration(input UpdateIntegrationInput) error {
 	return err
 }
 
+// TestIntegrationInput represents the input for testing the connection to an integration
+type TestIntegrationInput struct {
+	// ID of an existing integration, whose stored secrets fill in secrets not given;
+	// Homarr's schema requires the key, so new integrations send null
+	ID      *string             `json:"id"`
+	Kind    string              `json:"kind"`
+	URL     string              `json:"url"`
+	Secrets []IntegrationSecret `json:"secrets"`
+}
+
+// TestIntegrationConnection checks that Homarr can connect to an integration via tRPC.
+// Connection failures are returned as *IntegrationConnectionError.
+func (c *HomarrClient) TestIntegrationConnection(input TestIntegrationInput) error {
+	resp, err := c.doTRPCMutation("integration.testConnection", input)
+	if err != nil {
+		return err
+	}
+
+	return connectionError(resp)
+}
+
 // DeleteIntegration deletes an integration via tRPC
 func (c *HomarrClient) DeleteIntegration(id string) error {
 	input := map[string]string{"id": id}
@@ -571,6 +1286,63 @@ func (c *HomarrClient) DeleteIntegration(id string) error {
 	return err
 }
 
+// IntegrationPermissionValues are the permission levels that can be granted on an integration
+var IntegrationPermissionValues = []string{"use", "interact", "full"}
+
+// IntegrationPermission grants a user or group access to an integration
+type IntegrationPermission struct {
+	PrincipalID string `json:"principalId"`
+	Permission  string `json:"permission"`
+}
+
+// IntegrationPermissions represents the access granted to users and groups on an integration
+type IntegrationPermissions struct {
+	Users []struct {
+		User struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+		} `json:"user"`
+		Permission string `json:"permission"`
+	} `json:"users"`
+	Groups []struct {
+		Group struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+		} `json:"group"`
+		Permission string `json:"permission"`
+	} `json:"groups"`
+}
+
+// GetIntegrationPermissions retrieves the access granted on an integration via tRPC
+func (c *HomarrClient) GetIntegrationPermissions(id string) (*IntegrationPermissions, error) {
+	input := map[string]string{"id": id}
+	resp, err := c.doTRPCQueryWithInput("integration.getIntegrationPermissions", input)
+	if err != nil {
+		return nil, err
+	}
+
+	var permissions IntegrationPermissions
+	if err := json.Unmarshal(resp, &permissions); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal integration permissions: %w", err)
+	}
+
+	return &permissions, nil
+}
+
+// SaveIntegrationPermissionsInput represents the input for saving integration permissions
+type SaveIntegrationPermissionsInput struct {
+	EntityID    string                  `json:"entityId"`
+	Permissions []IntegrationPermission `json:"permissions"`
+}
+
+// SaveIntegrationPermissions replaces the user and group permissions of an integration via tRPC.
+// Users and groups are sent in one list; Homarr tells them apart by their ID.
+func (c *HomarrClient) SaveIntegrationPermissions(id string, permissions []IntegrationPermission) error {
+	input := SaveIntegrationPermissionsInput{EntityID: id, Permissions: permissions}
+	_, err := c.doTRPCMutation("integration.savePermissions", input)
+	return err
+}
+
 // =============================================================================
 // Board (tRPC)
 // =============================================================================
@@ -613,7 +1385,739 @@ func (c *HomarrClient) GetBoard(id string) (*Board, error) {
 		}
 	}
 
-	return nil, fmt.Errorf("board not found: %s", id)
+	return nil, &NotFoundError{Kind: "board", ID: id}
+}
+
+// BoardPageSettings represents the customizable settings of a board
+type BoardPageSettings struct {
+	PageTitle                 *string `json:"pageTitle"`
+	MetaTitle                 *string `json:"metaTitle"`
+	LogoImageURL              *string `json:"logoImageUrl"`
+	FaviconImageURL           *string `json:"faviconImageUrl"`
+	BackgroundImageURL        *string `json:"backgroundImageUrl"`
+	BackgroundImageAttachment string  `json:"backgroundImageAttachment"`
+	BackgroundImageRepeat     string  `json:"backgroundImageRepeat"`
+	BackgroundImageSize       string  `json:"backgroundImageSize"`
+	PrimaryColor              string  `json:"primaryColor"`
+	SecondaryColor            string  `json:"secondaryColor"`
+	Opacity                   int     `json:"opacity"`
+	CustomCSS                 *string `json:"customCss"`
+	IconColor                 *string `json:"iconColor"`
+	ItemRadius                string  `json:"itemRadius"`
+	DisableStatus             bool    `json:"disableStatus"`
+}
+
+// BoardDetail represents a board including its layouts, sections and items
+type BoardDetail struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	IsPublic bool   `json:"isPublic"`
+	BoardPageSettings
+	Layouts  []BoardLayout  `json:"layouts"`
+	Sections []BoardSection `json:"sections"`
+	Items    []BoardItem    `json:"items"`
+}
+
+// BoardLayout represents a responsive layout of a board
+type BoardLayout struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	ColumnCount int    `json:"columnCount"`
+	Breakpoint  int    `json:"breakpoint"`
+}
+
+// BoardSection represents a section of a board (empty, category or dynamic)
+type BoardSection struct {
+	ID        string                 `json:"id"`
+	Kind      string                 `json:"kind"`
+	XOffset   int                    `json:"xOffset"`
+	YOffset   int                    `json:"yOffset"`
+	Name      *string                `json:"name,omitempty"`
+	Collapsed *bool                  `json:"collapsed,omitempty"`
+	Options   map[string]interface{} `json:"options,omitempty"`
+	Layouts   []BoardSectionLayout   `json:"layouts,omitempty"`
+}
+
+// BoardSectionLayout represents the position of a dynamic section in a layout
+type BoardSectionLayout struct {
+	LayoutID        string `json:"layoutId"`
+	ParentSectionID string `json:"parentSectionId"`
+	XOffset         int    `json:"xOffset"`
+	YOffset         int    `json:"yOffset"`
+	Width           int    `json:"width"`
+	Height          int    `json:"height"`
+}
+
+// BoardItem represents an app or widget placed on a board
+type BoardItem struct {
+	ID              string                   `json:"id"`
+	Kind            string                   `json:"kind"`
+	Options         map[string]interface{}   `json:"options"`
+	AdvancedOptions BoardItemAdvancedOptions `json:"advancedOptions"`
+	IntegrationIDs  []string                 `json:"integrationIds"`
+	Layouts         []BoardItemLayout        `json:"layouts"`
+}
+
+// BoardItemAdvancedOptions represents the advanced options shared by all items
+type BoardItemAdvancedOptions struct {
+	Title            *string  `json:"title"`
+	CustomCSSClasses []string `json:"customCssClasses"`
+	BorderColor      string   `json:"borderColor"`
+}
+
+// BoardItemLayout represents the position of an item in a layout
+type BoardItemLayout struct {
+	LayoutID  string `json:"layoutId"`
+	SectionID string `json:"sectionId"`
+	XOffset   int    `json:"xOffset"`
+	YOffset   int    `json:"yOffset"`
+	Width     int    `json:"width"`
+	Height    int    `json:"height"`
+}
+
+// GetBoardByName retrieves a board with all of its content by name
+func (c *HomarrClient) GetBoardByName(name string) (*BoardDetail, error) {
+	input := map[string]string{"name": name}
+	resp, err := c.doTRPCQueryWithInput("board.getBoardByName", input)
+	if err != nil {
+		return nil, err
+	}
+
+	var board BoardDetail
+	if err := json.Unmarshal(resp, &board); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal board: %w", err)
+	}
+
+	return &board, nil
+}
+
+// GetBoardDetail retrieves a board with all of its content by ID
+func (c *HomarrClient) GetBoardDetail(id string) (*BoardDetail, error) {
+	board, err := c.GetBoard(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetBoardByName(board.Name)
+}
+
+// CreateBoardInput represents the input for creating a board
+type CreateBoardInput struct {
+	Name        string `json:"name"`
+	ColumnCount int    `json:"columnCount"`
+	IsPublic    bool   `json:"isPublic"`
+}
+
+// CreateBoard creates a new board via tRPC
+func (c *HomarrClient) CreateBoard(input CreateBoardInput) (*BoardDetail, error) {
+	_, err := c.doTRPCMutation("board.createBoard", input)
+	if err != nil {
+		return nil, err
+	}
+
+	// Board names are unique, so the created board can be fetched by name
+	return c.GetBoardByName(input.Name)
+}
+
+// SaveBoardSettingsInput represents the input for saving board settings
+type SaveBoardSettingsInput struct {
+	ID string `json:"id"`
+	BoardPageSettings
+}
+
+// SaveBoardSettings saves the settings of a board via tRPC
+func (c *HomarrClient) SaveBoardSettings(id string, settings BoardPageSettings) error {
+	input := SaveBoardSettingsInput{ID: id, BoardPageSettings: settings}
+	_, err := c.doTRPCMutation("board.savePartialBoardSettings", input)
+	return err
+}
+
+// SaveBoardLayoutsInput represents the input for saving board layouts
+type SaveBoardLayoutsInput struct {
+	ID      string        `json:"id"`
+	Layouts []BoardLayout `json:"layouts"`
+}
+
+// SaveBoardLayouts replaces the layouts of a board via tRPC
+func (c *HomarrClient) SaveBoardLayouts(id string, layouts []BoardLayout) error {
+	input := SaveBoardLayoutsInput{ID: id, Layouts: layouts}
+	_, err := c.doTRPCMutation("board.saveLayouts", input)
+	return err
+}
+
+// SaveBoardInput represents the input for saving board content
+type SaveBoardInput struct {
+	ID       string         `json:"id"`
+	Sections []BoardSection `json:"sections"`
+	Items    []BoardItem    `json:"items"`
+}
+
+// SaveBoard replaces the sections and items of a board via tRPC
+func (c *HomarrClient) SaveBoard(id string, sections []BoardSection, items []BoardItem) error {
+	input := SaveBoardInput{ID: id, Sections: sections, Items: items}
+	_, err := c.doTRPCMutation("board.saveBoard", input)
+	return err
+}
+
+// RenameBoard renames a board via tRPC
+func (c *HomarrClient) RenameBoard(id, name string) error {
+	input := map[string]string{"id": id, "name": name}
+	_, err := c.doTRPCMutation("board.renameBoard", input)
+	return err
+}
+
+// ChangeBoardVisibility changes whether a board is public via tRPC
+func (c *HomarrClient) ChangeBoardVisibility(id string, isPublic bool) error {
+	visibility := "private"
+	if isPublic {
+		visibility = "public"
+	}
+	input := map[string]string{"id": id, "visibility": visibility}
+	_, err := c.doTRPCMutation("board.changeBoardVisibility", input)
+	return err
+}
+
+// DeleteBoard deletes a board via tRPC
+func (c *HomarrClient) DeleteBoard(id string) error {
+	input := map[string]string{"id": id}
+	_, err := c.doTRPCMutation("board.deleteBoard", input)
+	return err
+}
+
+// DuplicateBoardInput represents the input for duplicating a board
+type DuplicateBoardInput struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	Visibility string `json:"visibility"`
+}
+
+// DuplicateBoard copies a board with all of its content under a new name via tRPC
+func (c *HomarrClient) DuplicateBoard(id, name string, isPublic bool) (*Board, error) {
+	visibility := "private"
+	if isPublic {
+		visibility = "public"
+	}
+
+	input := DuplicateBoardInput{ID: id, Name: name, Visibility: visibility}
+	if _, err := c.doTRPCMutation("board.duplicateBoard", input); err != nil {
+		return nil, err
+	}
+
+	boards, err := c.GetBoards()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, b := range boards {
+		if b.Name == name {
+			return &b, nil
+		}
+	}
+
+	return nil, fmt.Errorf("duplicated board not found")
+}
+
+// BoardPermission grants a user or group access to a board
+type BoardPermission struct {
+	PrincipalID string `json:"principalId"`
+	Permission  string `json:"permission"`
+}
+
+// SaveBoardPermissionsInput represents the input for saving board permissions
+type SaveBoardPermissionsInput struct {
+	EntityID    string            `json:"entityId"`
+	Permissions []BoardPermission `json:"permissions"`
+}
+
+// SaveBoardUserPermissions replaces the user permissions of a board via tRPC
+func (c *HomarrClient) SaveBoardUserPermissions(boardID string, permissions []BoardPermission) error {
+	input := SaveBoardPermissionsInput{EntityID: boardID, Permissions: permissions}
+	_, err := c.doTRPCMutation("board.saveUserBoardPermissions", input)
+	return err
+}
+
+// SaveBoardGroupPermissions replaces the group permissions of a board via tRPC
+func (c *HomarrClient) SaveBoardGroupPermissions(boardID string, permissions []BoardPermission) error {
+	input := SaveBoardPermissionsInput{EntityID: boardID, Permissions: permissions}
+	_, err := c.doTRPCMutation("board.saveGroupBoardPermissions", input)
+	return err
+}
+
+// newID generates an ID in the format Homarr uses for client-created objects
+// (sections, items and layouts are identified by IDs chosen by the client)
+func newID() (string, error) {
+	const letters = "abcdefghijklmnopqrstuvwxyz"
+	const alphabet = letters + "0123456789"
+
+	buf := make([]byte, 24)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate random ID: %w", err)
+	}
+
+	id := make([]byte, len(buf))
+	id[0] = letters[int(buf[0])%len(letters)]
+	for i := 1; i < len(buf); i++ {
+		id[i] = alphabet[int(buf[i])%len(alphabet)]
+	}
+
+	return string(id), nil
+}
+
+// boardContentMu serializes read-modify-write cycles of board content, as
+// board.saveBoard always replaces all sections and items of a board
+var boardContentMu sync.Mutex
+
+// ModifyBoardContent loads a board, lets modify change its sections and items and saves the result
+func (c *HomarrClient) ModifyBoardContent(boardID string, modify func(board *BoardDetail) error) error {
+	boardContentMu.Lock()
+	defer boardContentMu.Unlock()
+
+	board, err := c.GetBoardDetail(boardID)
+	if err != nil {
+		return err
+	}
+
+	if err := modify(board); err != nil {
+		return err
+	}
+
+	return c.SaveBoard(board.ID, board.Sections, board.Items)
+}
+
+// =============================================================================
+// Board Export
+// =============================================================================
+
+// boardExportVersion is the version of the board export document format
+const boardExportVersion = 1
+
+// BoardExport is the normalized JSON document produced by homarr_board_export
+// and consumed by homarr_board_import
+type BoardExport struct {
+	Version      int                    `json:"version"`
+	Name         string                 `json:"name"`
+	IsPublic     bool                   `json:"isPublic"`
+	Settings     BoardPageSettings      `json:"settings"`
+	Layouts      []BoardLayout          `json:"layouts"`
+	Sections     []BoardSection         `json:"sections"`
+	Items        []BoardItem            `json:"items"`
+	Apps         []BoardExportReference `json:"apps"`
+	Integrations []BoardExportReference `json:"integrations"`
+}
+
+// BoardExportReference records the name of an app or integration referenced
+// by the board, so that it can be remapped when the board is imported
+type BoardExportReference struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Kind string `json:"kind,omitempty"`
+}
+
+// boardItemAppIDs returns the app IDs referenced by an item's options
+func boardItemAppIDs(item BoardItem) []string {
+	switch item.Kind {
+	case "app":
+		if id, ok := item.Options["appId"].(string); ok && id != "" {
+			return []string{id}
+		}
+	case "bookmarks":
+		if ids, ok := item.Options["items"].([]interface{}); ok {
+			var result []string
+			for _, id := range ids {
+				if s, ok := id.(string); ok {
+					result = append(result, s)
+				}
+			}
+			return result
+		}
+	}
+
+	return nil
+}
+
+// remapBoardItemAppIDs rewrites the app IDs referenced by an item's options
+func remapBoardItemAppIDs(item *BoardItem, appIDs map[string]string) {
+	switch item.Kind {
+	case "app":
+		if id, ok := item.Options["appId"].(string); ok {
+			item.Options["appId"] = appIDs[id]
+		}
+	case "bookmarks":
+		if ids, ok := item.Options["items"].([]interface{}); ok {
+			remapped := make([]interface{}, 0, len(ids))
+			for _, id := range ids {
+				if s, ok := id.(string); ok {
+					remapped = append(remapped, appIDs[s])
+				}
+			}
+			item.Options["items"] = remapped
+		}
+	}
+}
+
+// ExportBoard builds a normalized export document for a board
+func (c *HomarrClient) ExportBoard(board *BoardDetail) (*BoardExport, error) {
+	export := &BoardExport{
+		Version:  boardExportVersion,
+		Name:     board.Name,
+		IsPublic: board.IsPublic,
+		Settings: board.BoardPageSettings,
+		Layouts:  append([]BoardLayout{}, board.Layouts...),
+		Sections: append([]BoardSection{}, board.Sections...),
+		Items:    append([]BoardItem{}, board.Items...),
+	}
+	normalizeBoardExport(export)
+
+	appIDs := map[string]bool{}
+	integrationIDs := map[string]bool{}
+	for _, item := range export.Items {
+		for _, id := range item.IntegrationIDs {
+			integrationIDs[id] = true
+		}
+		for _, id := range boardItemAppIDs(item) {
+			appIDs[id] = true
+		}
+	}
+
+	export.Apps = []BoardExportReference{}
+	if len(appIDs) > 0 {
+		apps, err := c.GetAppsTRPC()
+		if err != nil {
+			return nil, fmt.Errorf("failed to look up apps: %w", err)
+		}
+		for _, app := range apps {
+			if appIDs[app.ID] {
+				export.Apps = append(export.Apps, BoardExportReference{ID: app.ID, Name: app.Name})
+			}
+		}
+	}
+
+	export.Integrations = []BoardExportReference{}
+	if len(integrationIDs) > 0 {
+		integrations, err := c.GetIntegrations()
+		if err != nil {
+			return nil, fmt.Errorf("failed to look up integrations: %w", err)
+		}
+		for _, integration := range integrations {
+			if integrationIDs[integration.ID] {
+				export.Integrations = append(export.Integrations, BoardExportReference{
+					ID:   integration.ID,
+					Name: integration.Name,
+					Kind: integration.Kind,
+				})
+			}
+		}
+	}
+
+	sort.Slice(export.Apps, func(i, j int) bool { return export.Apps[i].ID < export.Apps[j].ID })
+	sort.Slice(export.Integrations, func(i, j int) bool { return export.Integrations[i].ID < export.Integrations[j].ID })
+
+	return export, nil
+}
+
+// normalizeBoardExport sorts the layouts, sections and items of an export by
+// position and ID, so that exporting the same board always yields the same JSON
+func normalizeBoardExport(export *BoardExport) {
+	sort.Slice(export.Layouts, func(i, j int) bool {
+		a, b := export.Layouts[i], export.Layouts[j]
+		if a.Breakpoint != b.Breakpoint {
+			return a.Breakpoint < b.Breakpoint
+		}
+		return a.ID < b.ID
+	})
+
+	for i := range export.Sections {
+		layouts := export.Sections[i].Layouts
+		sort.Slice(layouts, func(i, j int) bool { return layouts[i].LayoutID < layouts[j].LayoutID })
+	}
+	sort.Slice(export.Sections, func(i, j int) bool {
+		a, b := export.Sections[i], export.Sections[j]
+		if a.YOffset != b.YOffset {
+			return a.YOffset < b.YOffset
+		}
+		if a.XOffset != b.XOffset {
+			return a.XOffset < b.XOffset
+		}
+		return a.ID < b.ID
+	})
+
+	for i := range export.Items {
+		item := &export.Items[i]
+		if item.IntegrationIDs == nil {
+			item.IntegrationIDs = []string{}
+		}
+		sort.Strings(item.IntegrationIDs)
+		sort.Slice(item.Layouts, func(i, j int) bool { return item.Layouts[i].LayoutID < item.Layouts[j].LayoutID })
+	}
+	sort.Slice(export.Items, func(i, j int) bool {
+		return export.Items[i].ID < export.Items[j].ID
+	})
+}
+
+// ParseBoardExport parses and checks a board export document
+func ParseBoardExport(data string) (*BoardExport, error) {
+	var export BoardExport
+	if err := json.Unmarshal([]byte(data), &export); err != nil {
+		return nil, fmt.Errorf("failed to parse board JSON: %w", err)
+	}
+
+	if export.Version != boardExportVersion {
+		return nil, fmt.Errorf("unsupported board export version %d (expected %d)", export.Version, boardExportVersion)
+	}
+	if len(export.Layouts) == 0 {
+		return nil, fmt.Errorf("board JSON must contain at least one layout")
+	}
+
+	return &export, nil
+}
+
+// ImportBoardContent replaces the settings, layouts, sections and items of an
+// existing board with the content of an export document. App and integration
+// references are remapped by name, and all layout, section and item IDs are
+// regenerated so that a board can be copied within the same Homarr instance.
+func (c *HomarrClient) ImportBoardContent(boardID string, export *BoardExport) error {
+	appIDs, err := c.remapBoardApps(export.Apps)
+	if err != nil {
+		return err
+	}
+
+	integrationIDs, err := c.remapBoardIntegrations(export.Integrations)
+	if err != nil {
+		return err
+	}
+
+	layoutIDs := map[string]string{}
+	layouts := make([]BoardLayout, 0, len(export.Layouts))
+	for _, layout := range export.Layouts {
+		id, err := newID()
+		if err != nil {
+			return err
+		}
+		layoutIDs[layout.ID] = id
+		layout.ID = id
+		layouts = append(layouts, layout)
+	}
+
+	sectionIDs := map[string]string{}
+	for _, section := range export.Sections {
+		id, err := newID()
+		if err != nil {
+			return err
+		}
+		sectionIDs[section.ID] = id
+	}
+
+	sections := make([]BoardSection, 0, len(export.Sections))
+	for _, section := range export.Sections {
+		section.ID = sectionIDs[section.ID]
+		sectionLayouts := make([]BoardSectionLayout, 0, len(section.Layouts))
+		for _, l := range section.Layouts {
+			l.LayoutID = layoutIDs[l.LayoutID]
+			l.ParentSectionID = sectionIDs[l.ParentSectionID]
+			sectionLayouts = append(sectionLayouts, l)
+		}
+		section.Layouts = sectionLayouts
+		if section.Kind != "dynamic" {
+			section.Layouts = nil
+		}
+		sections = append(sections, section)
+	}
+
+	items := make([]BoardItem, 0, len(export.Items))
+	for _, item := range export.Items {
+		id, err := newID()
+		if err != nil {
+			return err
+		}
+		item.ID = id
+		if item.Options == nil {
+			item.Options = map[string]interface{}{}
+		}
+		remapBoardItemAppIDs(&item, appIDs)
+
+		mapped := make([]string, 0, len(item.IntegrationIDs))
+		for _, id := range item.IntegrationIDs {
+			mapped = append(mapped, integrationIDs[id])
+		}
+		item.IntegrationIDs = mapped
+
+		itemLayouts := make([]BoardItemLayout, 0, len(item.Layouts))
+		for _, l := range item.Layouts {
+			l.LayoutID = layoutIDs[l.LayoutID]
+			l.SectionID = sectionIDs[l.SectionID]
+			itemLayouts = append(itemLayouts, l)
+		}
+		item.Layouts = itemLayouts
+		items = append(items, item)
+	}
+
+	if err := c.SaveBoardSettings(boardID, export.Settings); err != nil {
+		return fmt.Errorf("failed to save board settings: %w", err)
+	}
+	if err := c.SaveBoardLayouts(boardID, layouts); err != nil {
+		return fmt.Errorf("failed to save board layouts: %w", err)
+	}
+	if err := c.SaveBoard(boardID, sections, items); err != nil {
+		return fmt.Errorf("failed to save board content: %w", err)
+	}
+
+	return nil
+}
+
+// remapBoardApps maps exported app IDs to the IDs of apps with the same name
+func (c *HomarrClient) remapBoardApps(refs []BoardExportReference) (map[string]string, error) {
+	result := map[string]string{}
+	if len(refs) == 0 {
+		return result, nil
+	}
+
+	apps, err := c.GetAppsTRPC()
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up apps: %w", err)
+	}
+
+	return matchBoardApps(refs, apps)
+}
+
+// matchBoardApps maps exported app IDs to the IDs of the apps with the same name
+func matchBoardApps(refs []BoardExportReference, apps []App) (map[string]string, error) {
+	result := map[string]string{}
+	byName := map[string]string{}
+	for _, app := range apps {
+		byName[app.Name] = app.ID
+	}
+
+	var missing []string
+	for _, ref := range refs {
+		id, ok := byName[ref.Name]
+		if !ok {
+			missing = append(missing, ref.Name)
+			continue
+		}
+		result[ref.ID] = id
+	}
+
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("apps referenced by the board do not exist: %s", strings.Join(missing, ", "))
+	}
+
+	return result, nil
+}
+
+// remapBoardIntegrations maps exported integration IDs to the IDs of
+// integrations with the same name and kind
+func (c *HomarrClient) remapBoardIntegrations(refs []BoardExportReference) (map[string]string, error) {
+	result := map[string]string{}
+	if len(refs) == 0 {
+		return result, nil
+	}
+
+	integrations, err := c.GetIntegrations()
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up integrations: %w", err)
+	}
+
+	return matchBoardIntegrations(refs, integrations)
+}
+
+// matchBoardIntegrations maps exported integration IDs to the IDs of the
+// integrations with the same name and kind
+func matchBoardIntegrations(refs []BoardExportReference, integrations []Integration) (map[string]string, error) {
+	result := map[string]string{}
+	var missing []string
+	for _, ref := range refs {
+		found := false
+		for _, integration := range integrations {
+			if integration.Name == ref.Name && (ref.Kind == "" || integration.Kind == ref.Kind) {
+				result[ref.ID] = integration.ID
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, fmt.Sprintf("%s (%s)", ref.Name, ref.Kind))
+		}
+	}
+
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("integrations referenced by the board do not exist: %s", strings.Join(missing, ", "))
+	}
+
+	return result, nil
+}
+
+// =============================================================================
+// Oldmarr Import (tRPC)
+// =============================================================================
+
+// OldmarrImportConfiguration represents the options for importing a Homarr 0.x board
+type OldmarrImportConfiguration struct {
+	Name             string `json:"name"`
+	OnlyImportApps   bool   `json:"onlyImportApps"`
+	ScreenSize       string `json:"screenSize"`
+	SidebarBehaviour string `json:"sidebarBehaviour"`
+}
+
+// ImportOldmarrBoard uploads a Homarr 0.x (oldmarr) JSON config and imports it
+// as a new board, creating the apps it references
+func (c *HomarrClient) ImportOldmarrBoard(fileName string, content []byte, config OldmarrImportConfiguration) (*Board, error) {
+	configJSON, err := json.Marshal(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal import configuration: %w", err)
+	}
+
+	fields := map[string]string{"configuration": string(configJSON)}
+	files := map[string]TRPCFormFile{"file": {Name: fileName, Content: content}}
+	if _, err := c.doTRPCFormMutation("board.importOldmarrConfig", fields, files); err != nil {
+		return nil, err
+	}
+
+	// The import doesn't return the board, so find it by its unique name
+	boards, err := c.GetBoards()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, b := range boards {
+		if b.Name == config.Name {
+			return &b, nil
+		}
+	}
+
+	return nil, fmt.Errorf("imported board not found")
+}
+
+// =============================================================================
+// Location (tRPC)
+// =============================================================================
+
+// LocationSearchResult represents a city returned by the geocoding search
+type LocationSearchResult struct {
+	ID          int64   `json:"id"`
+	Name        string  `json:"name"`
+	Country     string  `json:"country"`
+	CountryCode string  `json:"country_code"`
+	Latitude    float64 `json:"latitude"`
+	Longitude   float64 `json:"longitude"`
+	Population  *int64  `json:"population"`
+}
+
+// SearchCity looks up cities by name via tRPC (Homarr forwards the query to the Open-Meteo geocoding API)
+func (c *HomarrClient) SearchCity(query string) ([]LocationSearchResult, error) {
+	input := map[string]string{"query": query}
+	resp, err := c.doTRPCQueryWithInput("location.searchCity", input)
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		Results []LocationSearchResult `json:"results"`
+	}
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal locations: %w", err)
+	}
+
+	return result.Results, nil
 }
 
 // =============================================================================
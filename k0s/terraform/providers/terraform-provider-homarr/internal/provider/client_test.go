@@ -0,0 +1,216 @@
+package provider
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseBoardExport(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr string
+	}{
+		{
+			name: "valid",
+			data: `{"version": 1, "name": "media", "layouts": [{"id": "l1", "name": "Base", "columnCount": 10, "breakpoint": 0}]}`,
+		},
+		{
+			name:    "invalid JSON",
+			data:    `{"version": 1,`,
+			wantErr: "failed to parse board JSON",
+		},
+		{
+			name:    "unsupported version",
+			data:    `{"version": 2, "layouts": [{"id": "l1"}]}`,
+			wantErr: "unsupported board export version 2",
+		},
+		{
+			name:    "no layouts",
+			data:    `{"version": 1, "layouts": []}`,
+			wantErr: "at least one layout",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			export, err := ParseBoardExport(tt.data)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if export.Name != "media" || len(export.Layouts) != 1 {
+				t.Errorf("unexpected export: %+v", export)
+			}
+		})
+	}
+}
+
+func TestNormalizeBoardExport(t *testing.T) {
+	export := &BoardExport{
+		Layouts: []BoardLayout{
+			{ID: "b", Breakpoint: 800},
+			{ID: "z", Breakpoint: 0},
+			{ID: "a", Breakpoint: 800},
+		},
+		Sections: []BoardSection{
+			{ID: "s3", YOffset: 1, XOffset: 0},
+			{ID: "s2", YOffset: 0, XOffset: 1},
+			{ID: "s1", YOffset: 0, XOffset: 1},
+			{ID: "s0", YOffset: 0, XOffset: 0, Layouts: []BoardSectionLayout{{LayoutID: "z"}, {LayoutID: "a"}}},
+		},
+		Items: []BoardItem{
+			{ID: "i2", IntegrationIDs: []string{"y", "x"}},
+			{ID: "i1", Layouts: []BoardItemLayout{{LayoutID: "z"}, {LayoutID: "b"}}},
+		},
+	}
+
+	normalizeBoardExport(export)
+
+	var layouts, sections, items []string
+	for _, layout := range export.Layouts {
+		layouts = append(layouts, layout.ID)
+	}
+	for _, section := range export.Sections {
+		sections = append(sections, section.ID)
+	}
+	for _, item := range export.Items {
+		items = append(items, item.ID)
+	}
+
+	if want := []string{"z", "a", "b"}; !reflect.DeepEqual(layouts, want) {
+		t.Errorf("layouts: got %v, want %v", layouts, want)
+	}
+	if want := []string{"s0", "s1", "s2", "s3"}; !reflect.DeepEqual(sections, want) {
+		t.Errorf("sections: got %v, want %v", sections, want)
+	}
+	if want := []string{"i1", "i2"}; !reflect.DeepEqual(items, want) {
+		t.Errorf("items: got %v, want %v", items, want)
+	}
+	if got := export.Sections[0].Layouts[0].LayoutID; got != "a" {
+		t.Errorf("section layouts not sorted, first is %q", got)
+	}
+	if got := export.Items[0].Layouts[0].LayoutID; got != "b" {
+		t.Errorf("item layouts not sorted, first is %q", got)
+	}
+	if got := export.Items[0].IntegrationIDs; got == nil || len(got) != 0 {
+		t.Errorf("missing integration IDs should become an empty list, got %#v", got)
+	}
+	if want := []string{"x", "y"}; !reflect.DeepEqual(export.Items[1].IntegrationIDs, want) {
+		t.Errorf("integration IDs: got %v, want %v", export.Items[1].IntegrationIDs, want)
+	}
+}
+
+func TestMatchBoardApps(t *testing.T) {
+	apps := []App{{ID: "new-sonarr", Name: "Sonarr"}, {ID: "new-radarr", Name: "Radarr"}}
+
+	tests := []struct {
+		name    string
+		refs    []BoardExportReference
+		want    map[string]string
+		wantErr string
+	}{
+		{
+			name: "by name",
+			refs: []BoardExportReference{{ID: "old-sonarr", Name: "Sonarr"}, {ID: "old-radarr", Name: "Radarr"}},
+			want: map[string]string{"old-sonarr": "new-sonarr", "old-radarr": "new-radarr"},
+		},
+		{
+			name:    "missing",
+			refs:    []BoardExportReference{{ID: "old-lidarr", Name: "Lidarr"}, {ID: "old-sonarr", Name: "Sonarr"}},
+			wantErr: "apps referenced by the board do not exist: Lidarr",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := matchBoardApps(tt.refs, apps)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("expected error %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchBoardIntegrations(t *testing.T) {
+	integrations := []Integration{
+		{ID: "new-sonarr", Name: "Media", Kind: "sonarr"},
+		{ID: "new-radarr", Name: "Media", Kind: "radarr"},
+	}
+
+	tests := []struct {
+		name    string
+		refs    []BoardExportReference
+		want    map[string]string
+		wantErr string
+	}{
+		{
+			name: "by name and kind",
+			refs: []BoardExportReference{{ID: "old", Name: "Media", Kind: "radarr"}},
+			want: map[string]string{"old": "new-radarr"},
+		},
+		{
+			name: "by name without kind",
+			refs: []BoardExportReference{{ID: "old", Name: "Media"}},
+			want: map[string]string{"old": "new-sonarr"},
+		},
+		{
+			name:    "kind mismatch",
+			refs:    []BoardExportReference{{ID: "old", Name: "Media", Kind: "lidarr"}},
+			wantErr: "integrations referenced by the board do not exist: Media (lidarr)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := matchBoardIntegrations(tt.refs, integrations)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("expected error %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRemapBoardItemAppIDs(t *testing.T) {
+	appIDs := map[string]string{"old-a": "new-a", "old-b": "new-b"}
+
+	app := BoardItem{Kind: "app", Options: map[string]interface{}{"appId": "old-a"}}
+	remapBoardItemAppIDs(&app, appIDs)
+	if got := app.Options["appId"]; got != "new-a" {
+		t.Errorf("app: got %v, want new-a", got)
+	}
+
+	bookmarks := BoardItem{Kind: "bookmarks", Options: map[string]interface{}{"items": []interface{}{"old-b", "old-a"}}}
+	remapBoardItemAppIDs(&bookmarks, appIDs)
+	if got, want := bookmarks.Options["items"], []interface{}{"new-b", "new-a"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("bookmarks: got %v, want %v", got, want)
+	}
+
+	if got, want := boardItemAppIDs(BoardItem{Kind: "clock", Options: map[string]interface{}{"appId": "x"}}), []string(nil); !reflect.DeepEqual(got, want) {
+		t.Errorf("widgets don't reference apps, got %v", got)
+	}
+}
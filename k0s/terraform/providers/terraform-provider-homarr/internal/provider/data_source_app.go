@@ -0,0 +1,255 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &AppDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &AppDataSource{}
+var _ datasource.DataSource = &AppsDataSource{}
+
+func NewAppDataSource() datasource.DataSource {
+	return &AppDataSource{}
+}
+
+func NewAppsDataSource() datasource.DataSource {
+	return &AppsDataSource{}
+}
+
+// AppDataSource defines the data source implementation.
+type AppDataSource struct {
+	client *HomarrClient
+}
+
+// AppsDataSource defines the data source implementation.
+type AppsDataSource struct {
+	client *HomarrClient
+}
+
+// AppDataSourceModel describes the data source data model.
+type AppDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	IconURL     types.String `tfsdk:"icon_url"`
+	URL         types.String `tfsdk:"url"`
+	Description types.String `tfsdk:"description"`
+	PingURL     types.String `tfsdk:"ping_url"`
+}
+
+// AppsDataSourceModel describes the data source data model.
+type AppsDataSourceModel struct {
+	NameRegex types.String         `tfsdk:"name_regex"`
+	Apps      []AppDataSourceModel `tfsdk:"apps"`
+}
+
+// appDataSourceAttributes returns the computed attributes describing an app
+func appDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The unique identifier of the app.",
+		},
+		"name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The display name of the app.",
+		},
+		"icon_url": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The URL of the app icon.",
+		},
+		"url": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The URL the app links to (href).",
+		},
+		"description": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "A description of the app.",
+		},
+		"ping_url": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The URL to ping for health checks.",
+		},
+	}
+}
+
+func appToDataSourceModel(app App) AppDataSourceModel {
+	return AppDataSourceModel{
+		ID:          types.StringValue(app.ID),
+		Name:        types.StringValue(app.Name),
+		IconURL:     types.StringValue(app.IconURL),
+		URL:         stringValue(app.Href),
+		Description: stringValue(app.Description),
+		PingURL:     stringValue(app.PingURL),
+	}
+}
+
+func (d *AppDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app"
+}
+
+func (d *AppDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := appDataSourceAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "The ID of the app. Exactly one of `id` or `name` must be set.",
+	}
+	attributes["name"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "The exact name of the app. Exactly one of `id` or `name` must be set.",
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up an app by ID or name. Requires session_token authentication.",
+		Attributes:          attributes,
+	}
+}
+
+func (d *AppDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data AppDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateIDOrName(data.ID, data.Name, path.Root("id"))...)
+}
+
+func (d *AppDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*HomarrClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *HomarrClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *AppDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AppDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if d.client.SessionToken == "" {
+		resp.Diagnostics.AddError("Missing Session Token", "Apps require session_token authentication. Please configure session_token in the provider.")
+		return
+	}
+
+	apps, err := d.client.GetAppsTRPC()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read apps: %s", err))
+		return
+	}
+
+	var matches []App
+	for _, a := range apps {
+		if (!data.ID.IsNull() && a.ID == data.ID.ValueString()) || (!data.Name.IsNull() && a.Name == data.Name.ValueString()) {
+			matches = append(matches, a)
+		}
+	}
+
+	if len(matches) != 1 {
+		resp.Diagnostics.AddError("App Not Found", lookupErrorDetail("app", len(matches), data.ID, data.Name))
+		return
+	}
+
+	data = appToDataSourceModel(matches[0])
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *AppsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_apps"
+}
+
+func (d *AppsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists apps, optionally filtered by name. Requires session_token authentication.",
+
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return apps whose name matches this regular expression.",
+			},
+			"apps": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The matching apps.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: appDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *AppsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*HomarrClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *HomarrClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *AppsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AppsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if d.client.SessionToken == "" {
+		resp.Diagnostics.AddError("Missing Session Token", "Apps require session_token authentication. Please configure session_token in the provider.")
+		return
+	}
+
+	nameRegex, err := compileNameRegex(data.NameRegex)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid Regular Expression", err.Error())
+		return
+	}
+
+	apps, err := d.client.GetAppsTRPC()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read apps: %s", err))
+		return
+	}
+
+	data.Apps = []AppDataSourceModel{}
+	for _, a := range apps {
+		if nameRegex != nil && !nameRegex.MatchString(a.Name) {
+			continue
+		}
+		data.Apps = append(data.Apps, appToDataSourceModel(a))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
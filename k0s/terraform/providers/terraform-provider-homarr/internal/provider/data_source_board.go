@@ -0,0 +1,255 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &BoardDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &BoardDataSource{}
+var _ datasource.DataSource = &BoardsDataSource{}
+
+func NewBoardDataSource() datasource.DataSource {
+	return &BoardDataSource{}
+}
+
+func NewBoardsDataSource() datasource.DataSource {
+	return &BoardsDataSource{}
+}
+
+// BoardDataSource defines the data source implementation.
+type BoardDataSource struct {
+	client *HomarrClient
+}
+
+// BoardsDataSource defines the data source implementation.
+type BoardsDataSource struct {
+	client *HomarrClient
+}
+
+// BoardDataSourceModel describes the data source data model.
+type BoardDataSourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	LogoImageURL types.String `tfsdk:"logo_image_url"`
+	IsPublic     types.Bool   `tfsdk:"is_public"`
+	IsHome       types.Bool   `tfsdk:"is_home"`
+	IsMobileHome types.Bool   `tfsdk:"is_mobile_home"`
+}
+
+// BoardsDataSourceModel describes the data source data model.
+type BoardsDataSourceModel struct {
+	NameRegex types.String           `tfsdk:"name_regex"`
+	Boards    []BoardDataSourceModel `tfsdk:"boards"`
+}
+
+// boardDataSourceAttributes returns the computed attributes describing a board
+func boardDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The unique identifier of the board.",
+		},
+		"name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The name of the board.",
+		},
+		"logo_image_url": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The URL of the board logo.",
+		},
+		"is_public": schema.BoolAttribute{
+			Computed:            true,
+			MarkdownDescription: "Whether the board can be viewed without logging in.",
+		},
+		"is_home": schema.BoolAttribute{
+			Computed:            true,
+			MarkdownDescription: "Whether the board is the home board of the session user.",
+		},
+		"is_mobile_home": schema.BoolAttribute{
+			Computed:            true,
+			MarkdownDescription: "Whether the board is the mobile home board of the session user.",
+		},
+	}
+}
+
+func boardToDataSourceModel(board Board) BoardDataSourceModel {
+	return BoardDataSourceModel{
+		ID:           types.StringValue(board.ID),
+		Name:         types.StringValue(board.Name),
+		LogoImageURL: types.StringPointerValue(board.LogoImageURL),
+		IsPublic:     types.BoolValue(board.IsPublic),
+		IsHome:       types.BoolValue(board.IsHome),
+		IsMobileHome: types.BoolValue(board.IsMobileHome),
+	}
+}
+
+func (d *BoardDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_board"
+}
+
+func (d *BoardDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := boardDataSourceAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "The ID of the board. Exactly one of `id` or `name` must be set.",
+	}
+	attributes["name"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "The exact name of the board. Exactly one of `id` or `name` must be set.",
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up a board by ID or name. Requires session_token authentication.",
+		Attributes:          attributes,
+	}
+}
+
+func (d *BoardDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data BoardDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateIDOrName(data.ID, data.Name, path.Root("id"))...)
+}
+
+func (d *BoardDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*HomarrClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *HomarrClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *BoardDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data BoardDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if d.client.SessionToken == "" {
+		resp.Diagnostics.AddError("Missing Session Token", "Boards require session_token authentication. Please configure session_token in the provider.")
+		return
+	}
+
+	boards, err := d.client.GetBoards()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read boards: %s", err))
+		return
+	}
+
+	var matches []Board
+	for _, b := range boards {
+		if (!data.ID.IsNull() && b.ID == data.ID.ValueString()) || (!data.Name.IsNull() && b.Name == data.Name.ValueString()) {
+			matches = append(matches, b)
+		}
+	}
+
+	if len(matches) != 1 {
+		resp.Diagnostics.AddError("Board Not Found", lookupErrorDetail("board", len(matches), data.ID, data.Name))
+		return
+	}
+
+	data = boardToDataSourceModel(matches[0])
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *BoardsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_boards"
+}
+
+func (d *BoardsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists boards, optionally filtered by name. Requires session_token authentication.",
+
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return boards whose name matches this regular expression.",
+			},
+			"boards": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The matching boards, sorted as returned by Homarr.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: boardDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *BoardsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*HomarrClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *HomarrClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *BoardsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data BoardsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if d.client.SessionToken == "" {
+		resp.Diagnostics.AddError("Missing Session Token", "Boards require session_token authentication. Please configure session_token in the provider.")
+		return
+	}
+
+	nameRegex, err := compileNameRegex(data.NameRegex)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid Regular Expression", err.Error())
+		return
+	}
+
+	boards, err := d.client.GetBoards()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read boards: %s", err))
+		return
+	}
+
+	data.Boards = []BoardDataSourceModel{}
+	for _, b := range boards {
+		if nameRegex != nil && !nameRegex.MatchString(b.Name) {
+			continue
+		}
+		data.Boards = append(data.Boards, boardToDataSourceModel(b))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &BoardExportDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &BoardExportDataSource{}
+
+func NewBoardExportDataSource() datasource.DataSource {
+	return &BoardExportDataSource{}
+}
+
+// BoardExportDataSource defines the data source implementation.
+type BoardExportDataSource struct {
+	client *HomarrClient
+}
+
+// BoardExportDataSourceModel describes the data source data model.
+type BoardExportDataSourceModel struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+	JSON types.String `tfsdk:"json"`
+}
+
+func (d *BoardExportDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_board_export"
+}
+
+func (d *BoardExportDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Exports the complete structure of a board (settings, layouts, sections, items, widget options and integration links) " +
+			"as normalized JSON that can be recreated with `homarr_board_import`. Requires session_token authentication.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The ID of the board to export. Exactly one of `id` or `name` must be set.",
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The name of the board to export. Exactly one of `id` or `name` must be set.",
+			},
+			"json": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The normalized board JSON document.",
+			},
+		},
+	}
+}
+
+func (d *BoardExportDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data BoardExportDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateIDOrName(data.ID, data.Name, path.Root("id"))...)
+}
+
+func (d *BoardExportDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*HomarrClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *HomarrClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *BoardExportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data BoardExportDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if d.client.SessionToken == "" {
+		resp.Diagnostics.AddError("Missing Session Token", "Boards require session_token authentication. Please configure session_token in the provider.")
+		return
+	}
+
+	var board *BoardDetail
+	var err error
+	if !data.ID.IsNull() {
+		board, err = d.client.GetBoardDetail(data.ID.ValueString())
+	} else {
+		board, err = d.client.GetBoardByName(data.Name.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read board: %s", err))
+		return
+	}
+
+	export, err := d.client.ExportBoard(board)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to export board: %s", err))
+		return
+	}
+
+	exportJSON, err := json.MarshalIndent(export, "", "  ")
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to encode board export: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(board.ID)
+	data.Name = types.StringValue(board.Name)
+	data.JSON = types.StringValue(string(exportJSON))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
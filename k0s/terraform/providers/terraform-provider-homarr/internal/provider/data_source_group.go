@@ -0,0 +1,275 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GroupDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &GroupDataSource{}
+var _ datasource.DataSource = &GroupsDataSource{}
+
+func NewGroupDataSource() datasource.DataSource {
+	return &GroupDataSource{}
+}
+
+func NewGroupsDataSource() datasource.DataSource {
+	return &GroupsDataSource{}
+}
+
+// GroupDataSource defines the data source implementation.
+type GroupDataSource struct {
+	client *HomarrClient
+}
+
+// GroupsDataSource defines the data source implementation.
+type GroupsDataSource struct {
+	client *HomarrClient
+}
+
+// GroupDataSourceModel describes the data source data model.
+type GroupDataSourceModel struct {
+	ID       types.String                 `tfsdk:"id"`
+	Name     types.String                 `tfsdk:"name"`
+	Position types.Int64                  `tfsdk:"position"`
+	Members  []GroupMemberDataSourceModel `tfsdk:"members"`
+}
+
+// GroupMemberDataSourceModel describes a group member.
+type GroupMemberDataSourceModel struct {
+	ID    types.String `tfsdk:"id"`
+	Name  types.String `tfsdk:"name"`
+	Email types.String `tfsdk:"email"`
+}
+
+// GroupsDataSourceModel describes the data source data model.
+type GroupsDataSourceModel struct {
+	NameRegex types.String           `tfsdk:"name_regex"`
+	Groups    []GroupDataSourceModel `tfsdk:"groups"`
+}
+
+// groupDataSourceAttributes returns the computed attributes describing a group
+func groupDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The unique identifier of the group.",
+		},
+		"name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The name of the group.",
+		},
+		"position": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "The position of the group, which decides the precedence of group settings.",
+		},
+		"members": schema.ListNestedAttribute{
+			Computed:            true,
+			MarkdownDescription: "The users in the group.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The ID of the user.",
+					},
+					"name": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The username.",
+					},
+					"email": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The email address of the user.",
+					},
+				},
+			},
+		},
+	}
+}
+
+func groupToDataSourceModel(group Group) GroupDataSourceModel {
+	members := make([]GroupMemberDataSourceModel, 0, len(group.Members))
+	for _, m := range group.Members {
+		members = append(members, GroupMemberDataSourceModel{
+			ID:    types.StringValue(m.ID),
+			Name:  types.StringValue(m.Name),
+			Email: types.StringValue(m.Email),
+		})
+	}
+
+	return GroupDataSourceModel{
+		ID:       types.StringValue(group.ID),
+		Name:     types.StringValue(group.Name),
+		Position: types.Int64Value(int64(group.Position)),
+		Members:  members,
+	}
+}
+
+func (d *GroupDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group"
+}
+
+func (d *GroupDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := groupDataSourceAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "The ID of the group. Exactly one of `id` or `name` must be set.",
+	}
+	attributes["name"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "The exact name of the group (e.g., `everyone`). Exactly one of `id` or `name` must be set.",
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up a group by ID or name. Requires session_token authentication.",
+		Attributes:          attributes,
+	}
+}
+
+func (d *GroupDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data GroupDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateIDOrName(data.ID, data.Name, path.Root("id"))...)
+}
+
+func (d *GroupDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*HomarrClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *HomarrClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *GroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GroupDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if d.client.SessionToken == "" {
+		resp.Diagnostics.AddError("Missing Session Token", "Groups require session_token authentication. Please configure session_token in the provider.")
+		return
+	}
+
+	groups, err := d.client.GetGroups()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read groups: %s", err))
+		return
+	}
+
+	var matches []Group
+	for _, g := range groups {
+		if (!data.ID.IsNull() && g.ID == data.ID.ValueString()) || (!data.Name.IsNull() && g.Name == data.Name.ValueString()) {
+			matches = append(matches, g)
+		}
+	}
+
+	if len(matches) != 1 {
+		resp.Diagnostics.AddError("Group Not Found", lookupErrorDetail("group", len(matches), data.ID, data.Name))
+		return
+	}
+
+	data = groupToDataSourceModel(matches[0])
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *GroupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_groups"
+}
+
+func (d *GroupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists groups, optionally filtered by name. Requires session_token authentication.",
+
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return groups whose name matches this regular expression.",
+			},
+			"groups": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The matching groups.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: groupDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *GroupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*HomarrClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *HomarrClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *GroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GroupsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if d.client.SessionToken == "" {
+		resp.Diagnostics.AddError("Missing Session Token", "Groups require session_token authentication. Please configure session_token in the provider.")
+		return
+	}
+
+	nameRegex, err := compileNameRegex(data.NameRegex)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid Regular Expression", err.Error())
+		return
+	}
+
+	groups, err := d.client.GetGroups()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read groups: %s", err))
+		return
+	}
+
+	data.Groups = []GroupDataSourceModel{}
+	for _, g := range groups {
+		if nameRegex != nil && !nameRegex.MatchString(g.Name) {
+			continue
+		}
+		data.Groups = append(data.Groups, groupToDataSourceModel(g))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
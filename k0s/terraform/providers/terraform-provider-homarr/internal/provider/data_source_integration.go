@@ -0,0 +1,259 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &IntegrationDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &IntegrationDataSource{}
+var _ datasource.DataSource = &IntegrationsDataSource{}
+
+func NewIntegrationDataSource() datasource.DataSource {
+	return &IntegrationDataSource{}
+}
+
+func NewIntegrationsDataSource() datasource.DataSource {
+	return &IntegrationsDataSource{}
+}
+
+// IntegrationDataSource defines the data source implementation.
+type IntegrationDataSource struct {
+	client *HomarrClient
+}
+
+// IntegrationsDataSource defines the data source implementation.
+type IntegrationsDataSource struct {
+	client *HomarrClient
+}
+
+// IntegrationDataSourceModel describes the data source data model.
+type IntegrationDataSourceModel struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+	Kind types.String `tfsdk:"kind"`
+	URL  types.String `tfsdk:"url"`
+}
+
+// IntegrationsDataSourceModel describes the data source data model.
+type IntegrationsDataSourceModel struct {
+	NameRegex    types.String                 `tfsdk:"name_regex"`
+	Kind         types.String                 `tfsdk:"kind"`
+	Integrations []IntegrationDataSourceModel `tfsdk:"integrations"`
+}
+
+// integrationDataSourceAttributes returns the computed attributes describing an integration
+func integrationDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The unique identifier of the integration.",
+		},
+		"name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The display name of the integration.",
+		},
+		"kind": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The type of integration (e.g., sonarr, jellyfin, homeAssistant).",
+		},
+		"url": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The URL of the integration service.",
+		},
+	}
+}
+
+func integrationToDataSourceModel(integration Integration) IntegrationDataSourceModel {
+	return IntegrationDataSourceModel{
+		ID:   types.StringValue(integration.ID),
+		Name: types.StringValue(integration.Name),
+		Kind: types.StringValue(integration.Kind),
+		URL:  types.StringValue(integration.URL),
+	}
+}
+
+func (d *IntegrationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integration"
+}
+
+func (d *IntegrationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := integrationDataSourceAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "The ID of the integration. Exactly one of `id` or `name` must be set.",
+	}
+	attributes["name"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "The exact name of the integration. Exactly one of `id` or `name` must be set.",
+	}
+	attributes["kind"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "Only match integrations of this kind. Useful when several integrations share a name.",
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up an integration by ID or name. Requires session_token authentication.",
+		Attributes:          attributes,
+	}
+}
+
+func (d *IntegrationDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data IntegrationDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateIDOrName(data.ID, data.Name, path.Root("id"))...)
+}
+
+func (d *IntegrationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*HomarrClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *HomarrClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *IntegrationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IntegrationDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if d.client.SessionToken == "" {
+		resp.Diagnostics.AddError("Missing Session Token", "Integrations require session_token authentication. Please configure session_token in the provider.")
+		return
+	}
+
+	integrations, err := d.client.GetIntegrations()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read integrations: %s", err))
+		return
+	}
+
+	var matches []Integration
+	for _, i := range integrations {
+		if !data.Kind.IsNull() && i.Kind != data.Kind.ValueString() {
+			continue
+		}
+		if (!data.ID.IsNull() && i.ID == data.ID.ValueString()) || (!data.Name.IsNull() && i.Name == data.Name.ValueString()) {
+			matches = append(matches, i)
+		}
+	}
+
+	if len(matches) != 1 {
+		resp.Diagnostics.AddError("Integration Not Found", lookupErrorDetail("integration", len(matches), data.ID, data.Name))
+		return
+	}
+
+	data = integrationToDataSourceModel(matches[0])
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *IntegrationsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integrations"
+}
+
+func (d *IntegrationsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists integrations, optionally filtered by kind and name. Requires session_token authentication.",
+
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return integrations whose name matches this regular expression.",
+			},
+			"kind": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return integrations of this kind (e.g., `sonarr`).",
+			},
+			"integrations": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The matching integrations.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: integrationDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *IntegrationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*HomarrClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *HomarrClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *IntegrationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IntegrationsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if d.client.SessionToken == "" {
+		resp.Diagnostics.AddError("Missing Session Token", "Integrations require session_token authentication. Please configure session_token in the provider.")
+		return
+	}
+
+	nameRegex, err := compileNameRegex(data.NameRegex)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid Regular Expression", err.Error())
+		return
+	}
+
+	integrations, err := d.client.GetIntegrations()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read integrations: %s", err))
+		return
+	}
+
+	data.Integrations = []IntegrationDataSourceModel{}
+	for _, i := range integrations {
+		if !data.Kind.IsNull() && i.Kind != data.Kind.ValueString() {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(i.Name) {
+			continue
+		}
+		data.Integrations = append(data.Integrations, integrationToDataSourceModel(i))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
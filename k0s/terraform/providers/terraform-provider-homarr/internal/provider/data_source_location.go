@@ -0,0 +1,180 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &LocationDataSource{}
+
+func NewLocationDataSource() datasource.DataSource {
+	return &LocationDataSource{}
+}
+
+// LocationDataSource defines the data source implementation.
+type LocationDataSource struct {
+	client *HomarrClient
+}
+
+// LocationDataSourceModel describes the data source data model.
+type LocationDataSourceModel struct {
+	Query       types.String             `tfsdk:"query"`
+	CountryCode types.String             `tfsdk:"country_code"`
+	Name        types.String             `tfsdk:"name"`
+	Country     types.String             `tfsdk:"country"`
+	Latitude    types.Float64            `tfsdk:"latitude"`
+	Longitude   types.Float64            `tfsdk:"longitude"`
+	Candidates  []LocationCandidateModel `tfsdk:"candidates"`
+}
+
+// LocationCandidateModel describes a city matching the query.
+type LocationCandidateModel struct {
+	ID          types.Int64   `tfsdk:"id"`
+	Name        types.String  `tfsdk:"name"`
+	Country     types.String  `tfsdk:"country"`
+	CountryCode types.String  `tfsdk:"country_code"`
+	Latitude    types.Float64 `tfsdk:"latitude"`
+	Longitude   types.Float64 `tfsdk:"longitude"`
+	Population  types.Int64   `tfsdk:"population"`
+}
+
+func (d *LocationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_location"
+}
+
+func (d *LocationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up the coordinates of a city through Homarr's geocoding search, for use in weather widgets. " +
+			"The top-level attributes describe the best match; all matches are listed in `candidates`.",
+
+		Attributes: map[string]schema.Attribute{
+			"query": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The city name to search for (e.g., `Berlin`).",
+			},
+			"country_code": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only consider cities in this country, as ISO 3166-1 alpha-2 code (e.g., `DE`).",
+			},
+			"name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The name of the best match.",
+			},
+			"country": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The country of the best match.",
+			},
+			"latitude": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The latitude of the best match.",
+			},
+			"longitude": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The longitude of the best match.",
+			},
+			"candidates": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "All matching cities, best match first.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The GeoNames ID of the city.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the city.",
+						},
+						"country": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The country of the city.",
+						},
+						"country_code": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ISO 3166-1 alpha-2 code of the country.",
+						},
+						"latitude": schema.Float64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The latitude of the city.",
+						},
+						"longitude": schema.Float64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The longitude of the city.",
+						},
+						"population": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The population of the city, if known.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *LocationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*HomarrClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *HomarrClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *LocationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data LocationDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	results, err := d.client.SearchCity(data.Query.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to search locations: %s", err))
+		return
+	}
+
+	data.Candidates = []LocationCandidateModel{}
+	for _, result := range results {
+		if !data.CountryCode.IsNull() && !strings.EqualFold(result.CountryCode, data.CountryCode.ValueString()) {
+			continue
+		}
+		data.Candidates = append(data.Candidates, LocationCandidateModel{
+			ID:          types.Int64Value(result.ID),
+			Name:        types.StringValue(result.Name),
+			Country:     types.StringValue(result.Country),
+			CountryCode: types.StringValue(result.CountryCode),
+			Latitude:    types.Float64Value(result.Latitude),
+			Longitude:   types.Float64Value(result.Longitude),
+			Population:  types.Int64PointerValue(result.Population),
+		})
+	}
+
+	if len(data.Candidates) == 0 {
+		resp.Diagnostics.AddError("Location Not Found", fmt.Sprintf("No city found matching %q.", data.Query.ValueString()))
+		return
+	}
+
+	best := data.Candidates[0]
+	data.Name = best.Name
+	data.Country = best.Country
+	data.Latitude = best.Latitude
+	data.Longitude = best.Longitude
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
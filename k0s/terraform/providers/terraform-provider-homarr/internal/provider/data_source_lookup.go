@@ -0,0 +1,31 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// compileNameRegex compiles an optional name_regex filter
+func compileNameRegex(value types.String) (*regexp.Regexp, error) {
+	if value.IsNull() || value.ValueString() == "" {
+		return nil, nil
+	}
+
+	return regexp.Compile(value.ValueString())
+}
+
+// lookupErrorDetail describes why a lookup by ID or name didn't match exactly one object
+func lookupErrorDetail(kind string, matches int, id, name types.String) string {
+	selector := fmt.Sprintf("name %q", name.ValueString())
+	if !id.IsNull() {
+		selector = fmt.Sprintf("ID %q", id.ValueString())
+	}
+
+	if matches == 0 {
+		return fmt.Sprintf("No %s found with %s.", kind, selector)
+	}
+
+	return fmt.Sprintf("Found %d objects of type %s with %s, expected exactly one. Look it up by ID instead.", matches, kind, selector)
+}
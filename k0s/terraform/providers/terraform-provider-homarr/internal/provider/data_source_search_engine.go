@@ -0,0 +1,275 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SearchEngineDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &SearchEngineDataSource{}
+var _ datasource.DataSource = &SearchEnginesDataSource{}
+
+func NewSearchEngineDataSource() datasource.DataSource {
+	return &SearchEngineDataSource{}
+}
+
+func NewSearchEnginesDataSource() datasource.DataSource {
+	return &SearchEnginesDataSource{}
+}
+
+// SearchEngineDataSource defines the data source implementation.
+type SearchEngineDataSource struct {
+	client *HomarrClient
+}
+
+// SearchEnginesDataSource defines the data source implementation.
+type SearchEnginesDataSource struct {
+	client *HomarrClient
+}
+
+// SearchEngineDataSourceModel describes the data source data model.
+type SearchEngineDataSourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Type          types.String `tfsdk:"type"`
+	Name          types.String `tfsdk:"name"`
+	Short         types.String `tfsdk:"short"`
+	Description   types.String `tfsdk:"description"`
+	IconURL       types.String `tfsdk:"icon_url"`
+	URLTemplate   types.String `tfsdk:"url_template"`
+	IntegrationID types.String `tfsdk:"integration_id"`
+}
+
+// SearchEnginesDataSourceModel describes the data source data model.
+type SearchEnginesDataSourceModel struct {
+	NameRegex     types.String                  `tfsdk:"name_regex"`
+	Type          types.String                  `tfsdk:"type"`
+	SearchEngines []SearchEngineDataSourceModel `tfsdk:"search_engines"`
+}
+
+// searchEngineDataSourceAttributes returns the computed attributes describing a search engine
+func searchEngineDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The unique identifier of the search engine.",
+		},
+		"type": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The type of search engine: `generic` or `fromIntegration`.",
+		},
+		"name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The display name of the search engine.",
+		},
+		"short": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The keyboard shortcut of the search engine.",
+		},
+		"description": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "A description of the search engine.",
+		},
+		"icon_url": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The URL to the search engine's icon.",
+		},
+		"url_template": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The URL template with %s placeholder for the search query.",
+		},
+		"integration_id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The ID of the integration used for searching.",
+		},
+	}
+}
+
+func searchEngineToDataSourceModel(se SearchEngine) SearchEngineDataSourceModel {
+	return SearchEngineDataSourceModel{
+		ID:            types.StringValue(se.ID),
+		Type:          types.StringValue(se.Type),
+		Name:          types.StringValue(se.Name),
+		Short:         types.StringValue(se.Short),
+		Description:   types.StringValue(se.Description),
+		IconURL:       types.StringValue(se.IconURL),
+		URLTemplate:   types.StringValue(se.URLTemplate),
+		IntegrationID: types.StringPointerValue(se.IntegrationID),
+	}
+}
+
+func (d *SearchEngineDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_search_engine"
+}
+
+func (d *SearchEngineDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := searchEngineDataSourceAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "The ID of the search engine. Exactly one of `id` or `name` must be set.",
+	}
+	attributes["name"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "The exact name of the search engine. Exactly one of `id` or `name` must be set.",
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up a search engine by ID or name. Requires session_token authentication.",
+		Attributes:          attributes,
+	}
+}
+
+func (d *SearchEngineDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data SearchEngineDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateIDOrName(data.ID, data.Name, path.Root("id"))...)
+}
+
+func (d *SearchEngineDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*HomarrClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *HomarrClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SearchEngineDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SearchEngineDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if d.client.SessionToken == "" {
+		resp.Diagnostics.AddError("Missing Session Token", "Search engines require session_token authentication. Please configure session_token in the provider.")
+		return
+	}
+
+	searchEngines, err := d.client.GetSearchEngines()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read search engines: %s", err))
+		return
+	}
+
+	var matches []SearchEngine
+	for _, se := range searchEngines {
+		if (!data.ID.IsNull() && se.ID == data.ID.ValueString()) || (!data.Name.IsNull() && se.Name == data.Name.ValueString()) {
+			matches = append(matches, se)
+		}
+	}
+
+	if len(matches) != 1 {
+		resp.Diagnostics.AddError("Search Engine Not Found", lookupErrorDetail("search engine", len(matches), data.ID, data.Name))
+		return
+	}
+
+	data = searchEngineToDataSourceModel(matches[0])
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *SearchEnginesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_search_engines"
+}
+
+func (d *SearchEnginesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists search engines, optionally filtered by type and name. Requires session_token authentication.",
+
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return search engines whose name matches this regular expression.",
+			},
+			"type": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return search engines of this type: `generic` or `fromIntegration`.",
+			},
+			"search_engines": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The matching search engines.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: searchEngineDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *SearchEnginesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*HomarrClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *HomarrClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SearchEnginesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SearchEnginesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if d.client.SessionToken == "" {
+		resp.Diagnostics.AddError("Missing Session Token", "Search engines require session_token authentication. Please configure session_token in the provider.")
+		return
+	}
+
+	nameRegex, err := compileNameRegex(data.NameRegex)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid Regular Expression", err.Error())
+		return
+	}
+
+	searchEngines, err := d.client.GetSearchEngines()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read search engines: %s", err))
+		return
+	}
+
+	data.SearchEngines = []SearchEngineDataSourceModel{}
+	for _, se := range searchEngines {
+		if !data.Type.IsNull() && se.Type != data.Type.ValueString() {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(se.Name) {
+			continue
+		}
+		data.SearchEngines = append(data.SearchEngines, searchEngineToDataSourceModel(se))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// apiKeyPrivateKey is the private data key holding the ID of the key to delete on close
+const apiKeyPrivateKey = "api_key"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &APIKeyEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &APIKeyEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithClose = &APIKeyEphemeralResource{}
+
+func NewAPIKeyEphemeralResource() ephemeral.EphemeralResource {
+	return &APIKeyEphemeralResource{}
+}
+
+// APIKeyEphemeralResource defines the ephemeral resource implementation.
+type APIKeyEphemeralResource struct {
+	client *HomarrClient
+}
+
+// APIKeyEphemeralResourceModel describes the ephemeral resource data model.
+type APIKeyEphemeralResourceModel struct {
+	ID  types.String `tfsdk:"id"`
+	Key types.String `tfsdk:"key"`
+}
+
+// apiKeyPrivateData is the private data of an opened API key
+type apiKeyPrivateData struct {
+	ID string `json:"id"`
+}
+
+func (r *APIKeyEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_key"
+}
+
+func (r *APIKeyEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Creates a short-lived API key for the user of the session token. The key is never written to state or plan " +
+			"and is deleted again at the end of the Terraform run. Requires session_token authentication.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the API key.",
+			},
+			"key": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The API key, for use as `api_key` in a provider configuration.",
+			},
+		},
+	}
+}
+
+func (r *APIKeyEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*HomarrClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *HomarrClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *APIKeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data APIKeyEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.SessionToken == "" {
+		resp.Diagnostics.AddError("Missing Session Token", "API keys require session_token authentication. Please configure session_token in the provider.")
+		return
+	}
+
+	created, err := r.client.CreateAPIKey()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create API key: %s", err))
+		return
+	}
+
+	private, err := json.Marshal(apiKeyPrivateData{ID: created.ID()})
+	if err != nil {
+		resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("Unable to encode private data: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, apiKeyPrivateKey, private)...)
+
+	data.ID = types.StringValue(created.ID())
+	data.Key = types.StringValue(created.APIKey)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (r *APIKeyEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	private, diags := req.Private.GetKey(ctx, apiKeyPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || private == nil {
+		return
+	}
+
+	var data apiKeyPrivateData
+	if err := json.Unmarshal(private, &data); err != nil {
+		resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("Unable to decode private data: %s", err))
+		return
+	}
+
+	if err := r.client.DeleteAPIKey(data.ID); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete API key: %s", err))
+		return
+	}
+}
@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"sort"
+	"strings"
+)
+
+// IntegrationCatalogueVersion is the Homarr release the integration catalogue matches.
+// Update it together with the catalogue when supporting a newer Homarr version.
+const IntegrationCatalogueVersion = "1.40"
+
+// integrationDefinition describes an integration kind supported by Homarr
+type integrationDefinition struct {
+	Kind string
+	// SecretKinds are the accepted combinations of secret kinds; an empty combination means no credentials
+	SecretKinds [][]string
+}
+
+// integrationCatalogue lists the integration kinds of the Homarr release IntegrationCatalogueVersion
+var integrationCatalogue = []integrationDefinition{
+	// Download clients
+	{Kind: "sabNzbd", SecretKinds: [][]string{{"apiKey"}}},
+	{Kind: "nzbGet", SecretKinds: [][]string{{"username", "password"}}},
+	{Kind: "deluge", SecretKinds: [][]string{{"password"}}},
+	{Kind: "transmission", SecretKinds: [][]string{{"username", "password"}}},
+	{Kind: "qBittorrent", SecretKinds: [][]string{{"username", "password"}}},
+	{Kind: "aria2", SecretKinds: [][]string{{}, {"apiKey"}}},
+
+	// Media management
+	{Kind: "sonarr", SecretKinds: [][]string{{"apiKey"}}},
+	{Kind: "radarr", SecretKinds: [][]string{{"apiKey"}}},
+	{Kind: "lidarr", SecretKinds: [][]string{{"apiKey"}}},
+	{Kind: "readarr", SecretKinds: [][]string{{"apiKey"}}},
+	{Kind: "prowlarr", SecretKinds: [][]string{{"apiKey"}}},
+	{Kind: "tdarr", SecretKinds: [][]string{{}}},
+
+	// Media servers and requests
+	{Kind: "jellyfin", SecretKinds: [][]string{{"username", "password"}, {"apiKey"}}},
+	{Kind: "emby", SecretKinds: [][]string{{"apiKey"}}},
+	{Kind: "plex", SecretKinds: [][]string{{"apiKey"}}},
+	{Kind: "jellyseerr", SecretKinds: [][]string{{"apiKey"}}},
+	{Kind: "overseerr", SecretKinds: [][]string{{"apiKey"}}},
+
+	// Network
+	{Kind: "piHole", SecretKinds: [][]string{{"apiKey"}, {}}},
+	{Kind: "adGuardHome", SecretKinds: [][]string{{"username", "password"}}},
+	{Kind: "unifiController", SecretKinds: [][]string{{"username", "password"}}},
+	{Kind: "opnsense", SecretKinds: [][]string{{"opnsenseApiKey", "opnsenseApiSecret"}}},
+
+	// Smart home and monitoring
+	{Kind: "homeAssistant", SecretKinds: [][]string{{"apiKey"}}},
+	{Kind: "openmediavault", SecretKinds: [][]string{{"username", "password"}}},
+	{Kind: "dashDot", SecretKinds: [][]string{{}}},
+	{Kind: "proxmox", SecretKinds: [][]string{{"username", "tokenId", "apiKey", "realm"}}},
+	{Kind: "truenas", SecretKinds: [][]string{{"username", "password"}}},
+
+	// Productivity and notifications
+	{Kind: "nextcloud", SecretKinds: [][]string{{"username", "password"}}},
+	{Kind: "ntfy", SecretKinds: [][]string{{"topic"}, {"topic", "apiKey"}}},
+
+	// Release providers
+	{Kind: "github", SecretKinds: [][]string{{}, {"personalAccessToken"}, {"githubAppId", "githubInstallationId", "privateKey"}}},
+	{Kind: "dockerHub", SecretKinds: [][]string{{}, {"username", "personalAccessToken"}}},
+	{Kind: "gitHubContainerRegistry", SecretKinds: [][]string{{}, {"personalAccessToken"}}},
+	{Kind: "gitlab", SecretKinds: [][]string{{}, {"personalAccessToken"}}},
+	{Kind: "codeberg", SecretKinds: [][]string{{}, {"personalAccessToken"}}},
+	{Kind: "quay", SecretKinds: [][]string{{}, {"personalAccessToken"}}},
+	{Kind: "npm", SecretKinds: [][]string{{}}},
+	{Kind: "linuxServerIO", SecretKinds: [][]string{{}}},
+}
+
+// findIntegrationDefinition returns the catalogue entry of an integration kind
+func findIntegrationDefinition(kind string) (*integrationDefinition, bool) {
+	for i := range integrationCatalogue {
+		if integrationCatalogue[i].Kind == kind {
+			return &integrationCatalogue[i], true
+		}
+	}
+	return nil, false
+}
+
+// integrationKinds returns all kinds in the catalogue, sorted
+func integrationKinds() []string {
+	kinds := make([]string, 0, len(integrationCatalogue))
+	for _, definition := range integrationCatalogue {
+		kinds = append(kinds, definition.Kind)
+	}
+	sort.Strings(kinds)
+	return kinds
+}
+
+// acceptsSecrets reports whether the given secret kinds form one of the accepted combinations
+func (d *integrationDefinition) acceptsSecrets(secretKinds []string) bool {
+	for _, combination := range d.SecretKinds {
+		if len(combination) != len(secretKinds) {
+			continue
+		}
+		matches := true
+		for _, kind := range combination {
+			if !containsString(secretKinds, kind) {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return true
+		}
+	}
+	return false
+}
+
+// describeSecretKinds lists the accepted secret combinations for use in diagnostics
+func (d *integrationDefinition) describeSecretKinds() string {
+	descriptions := make([]string, 0, len(d.SecretKinds))
+	for _, combination := range d.SecretKinds {
+		if len(combination) == 0 {
+			descriptions = append(descriptions, "no credentials")
+			continue
+		}
+		descriptions = append(descriptions, strings.Join(combination, " + "))
+	}
+	return strings.Join(descriptions, ", or ")
+}
+
+// closestIntegrationKind returns the catalogue kind most similar to the given one, or "" if none is close
+func closestIntegrationKind(kind string) string {
+	best, bestDistance := "", -1
+	for _, candidate := range integrationKinds() {
+		// Compare case-insensitively, the most common typo is the capitalization (e.g. qbittorrent)
+		distance := levenshtein(strings.ToLower(kind), strings.ToLower(candidate))
+		if bestDistance == -1 || distance < bestDistance {
+			best, bestDistance = candidate, distance
+		}
+	}
+
+	// Suggestions further away than a third of the name are rarely what was meant
+	if bestDistance > len(best)/3+1 {
+		return ""
+	}
+	return best
+}
+
+// levenshtein returns the edit distance between two strings
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	previous := make([]int, len(rb)+1)
+	current := make([]int, len(rb)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		current[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+
+	return previous[len(rb)]
+}
+
+// containsString reports whether values contains value
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
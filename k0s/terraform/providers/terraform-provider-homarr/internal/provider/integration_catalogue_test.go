@@ -0,0 +1,109 @@
+package provider
+
+import "testing"
+
+func TestIntegrationCatalogue(t *testing.T) {
+	kinds := map[string]bool{}
+	for _, definition := range integrationCatalogue {
+		if kinds[definition.Kind] {
+			t.Errorf("duplicate kind %q", definition.Kind)
+		}
+		kinds[definition.Kind] = true
+
+		if len(definition.SecretKinds) == 0 {
+			t.Errorf("%s: no accepted secret combination", definition.Kind)
+		}
+		for _, combination := range definition.SecretKinds {
+			for _, secretKind := range combination {
+				if !containsString(IntegrationSecretKinds, secretKind) {
+					t.Errorf("%s: unknown secret kind %q", definition.Kind, secretKind)
+				}
+			}
+		}
+	}
+}
+
+func TestAcceptsSecrets(t *testing.T) {
+	tests := []struct {
+		kind        string
+		secretKinds []string
+		want        bool
+	}{
+		{kind: "sonarr", secretKinds: []string{"apiKey"}, want: true},
+		{kind: "sonarr", secretKinds: nil, want: false},
+		{kind: "sonarr", secretKinds: []string{"apiKey", "username"}, want: false},
+		{kind: "qBittorrent", secretKinds: []string{"password", "username"}, want: true},
+		{kind: "qBittorrent", secretKinds: []string{"username"}, want: false},
+		{kind: "jellyfin", secretKinds: []string{"apiKey"}, want: true},
+		{kind: "jellyfin", secretKinds: []string{"username", "password"}, want: true},
+		{kind: "aria2", secretKinds: nil, want: true},
+		{kind: "aria2", secretKinds: []string{"apiKey"}, want: true},
+		{kind: "dashDot", secretKinds: []string{"apiKey"}, want: false},
+		{kind: "proxmox", secretKinds: []string{"realm", "apiKey", "tokenId", "username"}, want: true},
+		{kind: "github", secretKinds: []string{"githubAppId", "privateKey"}, want: false},
+	}
+
+	for _, tt := range tests {
+		definition, ok := findIntegrationDefinition(tt.kind)
+		if !ok {
+			t.Fatalf("kind %q not in catalogue", tt.kind)
+		}
+		if got := definition.acceptsSecrets(tt.secretKinds); got != tt.want {
+			t.Errorf("%s with %v: got %t, want %t", tt.kind, tt.secretKinds, got, tt.want)
+		}
+	}
+}
+
+func TestDescribeSecretKinds(t *testing.T) {
+	definition, _ := findIntegrationDefinition("dockerHub")
+	if got, want := definition.describeSecretKinds(), "no credentials, or username + personalAccessToken"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestClosestIntegrationKind(t *testing.T) {
+	tests := []struct {
+		kind string
+		want string
+	}{
+		{kind: "qbittorrent", want: "qBittorrent"},
+		{kind: "sonar", want: "sonarr"},
+		{kind: "homeassistant", want: "homeAssistant"},
+		{kind: "jelyfin", want: "jellyfin"},
+		{kind: "piHole", want: "piHole"},
+		{kind: "kubernetes", want: ""},
+		{kind: "x", want: ""},
+	}
+
+	for _, tt := range tests {
+		if got := closestIntegrationKind(tt.kind); got != tt.want {
+			t.Errorf("%q: got %q, want %q", tt.kind, got, tt.want)
+		}
+	}
+}
+
+func TestLevenshtein(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{a: "", b: "", want: 0},
+		{a: "abc", b: "", want: 3},
+		{a: "", b: "abc", want: 3},
+		{a: "sonarr", b: "sonarr", want: 0},
+		{a: "sonar", b: "sonarr", want: 1},
+		{a: "radarr", b: "lidarr", want: 2},
+		{a: "kitten", b: "sitting", want: 3},
+		{a: "qBittorrent", b: "qbittorrent", want: 1},
+		{a: "über", b: "uber", want: 1},
+	}
+
+	for _, tt := range tests {
+		if got := levenshtein(tt.a, tt.b); got != tt.want {
+			t.Errorf("levenshtein(%q, %q): got %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+		if got := levenshtein(tt.b, tt.a); got != tt.want {
+			t.Errorf("levenshtein(%q, %q): got %d, want %d", tt.b, tt.a, got, tt.want)
+		}
+	}
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"os"
 
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -13,6 +15,8 @@ import (
 
 // Ensure HomarrProvider satisfies various provider interfaces.
 var _ provider.Provider = &HomarrProvider{}
+var _ provider.ProviderWithActions = &HomarrProvider{}
+var _ provider.ProviderWithEphemeralResources = &HomarrProvider{}
 
 // HomarrProvider defines the provider implementation.
 type HomarrProvider struct {
@@ -100,19 +104,57 @@ func (p *HomarrProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	client := NewHomarrClient(url, apiKey, sessionToken)
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.ActionData = client
+	resp.EphemeralResourceData = client
 }
 
 func (p *HomarrProvider) Resources(ctx context.Context) []func() resource.Resource {
-	return []func() resource.Resource{
+	resources := []func() resource.Resource{
+		NewAPIKeyResource,
 		NewAppResource,
+		NewBoardImportResource,
 		NewGroupResource,
+		NewGroupMembershipResource,
+		NewGroupOrderResource,
 		NewIntegrationResource,
+		NewInviteResource,
+		NewOldmarrImportResource,
 		NewSearchEngineResource,
+		NewServerSettingsResource,
+		NewUserResource,
 	}
+
+	return append(resources, widgetResources()...)
 }
 
 func (p *HomarrProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewAppDataSource,
+		NewAppsDataSource,
+		NewBoardDataSource,
+		NewBoardExportDataSource,
+		NewBoardsDataSource,
+		NewGroupDataSource,
+		NewGroupsDataSource,
+		NewIntegrationDataSource,
+		NewIntegrationsDataSource,
+		NewLocationDataSource,
+		NewSearchEngineDataSource,
+		NewSearchEnginesDataSource,
+	}
+}
+
+func (p *HomarrProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewAPIKeyEphemeralResource,
+	}
+}
+
+func (p *HomarrProvider) Actions(ctx context.Context) []func() action.Action {
+	return []func() action.Action{
+		NewDuplicateBoardAction,
+		NewTestIntegrationAction,
+	}
 }
 
 func New(version string) func() provider.Provider {
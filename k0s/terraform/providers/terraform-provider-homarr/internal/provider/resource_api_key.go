@@ -0,0 +1,197 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &APIKeyResource{}
+var _ resource.ResourceWithImportState = &APIKeyResource{}
+
+func NewAPIKeyResource() resource.Resource {
+	return &APIKeyResource{}
+}
+
+// APIKeyResource defines the resource implementation.
+type APIKeyResource struct {
+	client *HomarrClient
+}
+
+// APIKeyResourceModel describes the resource data model.
+type APIKeyResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	Key      types.String `tfsdk:"key"`
+	UserID   types.String `tfsdk:"user_id"`
+	Username types.String `tfsdk:"username"`
+}
+
+func (r *APIKeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_key"
+}
+
+func (r *APIKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an API key for the REST API, owned by the user of the session token. " +
+			"The key is only available on creation and is stored in state; use the `homarr_api_key` ephemeral resource to keep it out of state. " +
+			"Requires session_token authentication.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the API key.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"key": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The API key, for use as `api_key` in the provider configuration. Null after import.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the user owning the key.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"username": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The name of the user owning the key.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *APIKeyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*HomarrClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *HomarrClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *APIKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data APIKeyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.SessionToken == "" {
+		resp.Diagnostics.AddError("Missing Session Token", "API keys require session_token authentication. Please configure session_token in the provider.")
+		return
+	}
+
+	created, err := r.client.CreateAPIKey()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create API key: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(created.ID())
+	data.Key = types.StringValue(created.APIKey)
+
+	// Refresh from API
+	key, err := r.client.GetAPIKey(created.ID())
+	if err != nil {
+		// Save the key anyway, it can't be retrieved again
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read API key: %s", err))
+		return
+	}
+
+	data.UserID = types.StringValue(key.User.ID)
+	data.Username = types.StringValue(key.User.Name)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *APIKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data APIKeyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.SessionToken == "" {
+		resp.Diagnostics.AddError("Missing Session Token", "API keys require session_token authentication. Please configure session_token in the provider.")
+		return
+	}
+
+	key, err := r.client.GetAPIKey(data.ID.ValueString())
+	if IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read API key: %s", err))
+		return
+	}
+
+	data.UserID = types.StringValue(key.User.ID)
+	data.Username = types.StringValue(key.User.Name)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *APIKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// The resource has no configurable attributes; only computed values can change here
+	var data APIKeyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *APIKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data APIKeyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.SessionToken == "" {
+		resp.Diagnostics.AddError("Missing Session Token", "API keys require session_token authentication. Please configure session_token in the provider.")
+		return
+	}
+
+	err := r.client.DeleteAPIKey(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete API key: %s", err))
+		return
+	}
+}
+
+func (r *APIKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
@@ -0,0 +1,263 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &BoardImportResource{}
+var _ resource.ResourceWithImportState = &BoardImportResource{}
+var _ resource.ResourceWithValidateConfig = &BoardImportResource{}
+
+func NewBoardImportResource() resource.Resource {
+	return &BoardImportResource{}
+}
+
+// BoardImportResource defines the resource implementation.
+type BoardImportResource struct {
+	client *HomarrClient
+}
+
+// BoardImportResourceModel describes the resource data model.
+type BoardImportResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	IsPublic  types.Bool   `tfsdk:"is_public"`
+	BoardJSON types.String `tfsdk:"board_json"`
+}
+
+func (r *BoardImportResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_board_import"
+}
+
+func (r *BoardImportResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Creates a board from the JSON produced by the `homarr_board_export` data source. App and integration references " +
+			"are remapped by name, so the referenced apps and integrations must already exist. The JSON is authoritative: changing it replaces " +
+			"all sections and items of the board. Requires session_token authentication.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the board.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the board. Board names are unique and used in board URLs.",
+			},
+			"is_public": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether the board can be viewed without logging in. Defaults to the value in the JSON.",
+			},
+			"board_json": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The board JSON, usually `data.homarr_board_export.<name>.json` or a file exported earlier.",
+			},
+		},
+	}
+}
+
+func (r *BoardImportResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data BoardImportResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.BoardJSON.IsNull() || data.BoardJSON.IsUnknown() {
+		return
+	}
+
+	if _, err := ParseBoardExport(data.BoardJSON.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("board_json"), "Invalid Board JSON", err.Error())
+	}
+}
+
+func (r *BoardImportResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*HomarrClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *HomarrClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *BoardImportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data BoardImportResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.SessionToken == "" {
+		resp.Diagnostics.AddError("Missing Session Token", "Boards require session_token authentication. Please configure session_token in the provider.")
+		return
+	}
+
+	export, err := ParseBoardExport(data.BoardJSON.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("board_json"), "Invalid Board JSON", err.Error())
+		return
+	}
+
+	isPublic := export.IsPublic
+	if !data.IsPublic.IsNull() && !data.IsPublic.IsUnknown() {
+		isPublic = data.IsPublic.ValueBool()
+	}
+
+	created, err := r.client.CreateBoard(CreateBoardInput{
+		Name:        data.Name.ValueString(),
+		ColumnCount: export.Layouts[0].ColumnCount,
+		IsPublic:    isPublic,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create board: %s", err))
+		return
+	}
+
+	// Save the ID first so a failed import does not leave an untracked board
+	data.ID = types.StringValue(created.ID)
+	data.Name = types.StringValue(created.Name)
+	data.IsPublic = types.BoolValue(created.IsPublic)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if err := r.client.ImportBoardContent(created.ID, export); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import board content: %s", err))
+		return
+	}
+}
+
+func (r *BoardImportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data BoardImportResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.SessionToken == "" {
+		resp.Diagnostics.AddError("Missing Session Token", "Boards require session_token authentication. Please configure session_token in the provider.")
+		return
+	}
+
+	board, err := r.client.GetBoard(data.ID.ValueString())
+	if IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read board: %s", err))
+		return
+	}
+
+	data.Name = types.StringValue(board.Name)
+	data.IsPublic = types.BoolValue(board.IsPublic)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BoardImportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state BoardImportResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.SessionToken == "" {
+		resp.Diagnostics.AddError("Missing Session Token", "Boards require session_token authentication. Please configure session_token in the provider.")
+		return
+	}
+
+	export, err := ParseBoardExport(data.BoardJSON.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("board_json"), "Invalid Board JSON", err.Error())
+		return
+	}
+
+	boardID := data.ID.ValueString()
+
+	if !data.Name.Equal(state.Name) {
+		if err := r.client.RenameBoard(boardID, data.Name.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to rename board: %s", err))
+			return
+		}
+	}
+
+	isPublic := export.IsPublic
+	if !data.IsPublic.IsNull() && !data.IsPublic.IsUnknown() {
+		isPublic = data.IsPublic.ValueBool()
+	}
+	if isPublic != state.IsPublic.ValueBool() {
+		if err := r.client.ChangeBoardVisibility(boardID, isPublic); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to change board visibility: %s", err))
+			return
+		}
+	}
+
+	if !data.BoardJSON.Equal(state.BoardJSON) {
+		if err := r.client.ImportBoardContent(boardID, export); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import board content: %s", err))
+			return
+		}
+	}
+
+	// Refresh from API
+	board, err := r.client.GetBoard(boardID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read board after update: %s", err))
+		return
+	}
+
+	data.Name = types.StringValue(board.Name)
+	data.IsPublic = types.BoolValue(board.IsPublic)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BoardImportResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data BoardImportResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.SessionToken == "" {
+		resp.Diagnostics.AddError("Missing Session Token", "Boards require session_token authentication. Please configure session_token in the provider.")
+		return
+	}
+
+	err := r.client.DeleteBoard(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete board: %s", err))
+		return
+	}
+}
+
+func (r *BoardImportResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
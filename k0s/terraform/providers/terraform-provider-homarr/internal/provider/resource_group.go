@@ -4,17 +4,20 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &GroupResource{}
 var _ resource.ResourceWithImportState = &GroupResource{}
+var _ resource.ResourceWithModifyPlan = &GroupResource{}
 
 func NewGroupResource() resource.Resource {
 	return &GroupResource{}
@@ -27,8 +30,12 @@ type GroupResource struct {
 
 // GroupResourceModel describes the resource data model.
 type GroupResourceModel struct {
-	ID   types.String `tfsdk:"id"`
-	Name types.String `tfsdk:"name"`
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Members           types.Set    `tfsdk:"members"`
+	Permissions       types.Set    `tfsdk:"permissions"`
+	HomeBoardID       types.String `tfsdk:"home_board_id"`
+	MobileHomeBoardID types.String `tfsdk:"mobile_home_board_id"`
 }
 
 func (r *GroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -37,7 +44,8 @@ func (r *GroupResource) Metadata(ctx context.Context, req resource.MetadataReque
 
 func (r *GroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages a group in Homarr. Requires session_token authentication.",
+		MarkdownDescription: "Manages a group in Homarr. The built-in `everyone` and `credentials-admin` groups are adopted instead of created, " +
+			"can't be renamed and are only removed from state on destroy. Requires session_token authentication.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -51,10 +59,80 @@ func (r *GroupResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Required:            true,
 				MarkdownDescription: "The name of the group.",
 			},
+			"members": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				MarkdownDescription: "The IDs of the users in the group. When set, the list is authoritative: members added elsewhere are removed. " +
+					"Leave unset to manage membership with `homarr_group_membership` or in the UI instead.",
+			},
+			"permissions": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				MarkdownDescription: "The global permissions granted to the group's members (e.g., `board-create`, `integration-use-all`). " +
+					"When set, the list is authoritative. Leave unset to manage permissions in the UI.",
+				Validators: []validator.Set{
+					setValuesOneOf(GroupPermissions...),
+				},
+			},
+			"home_board_id": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "The ID of the board members see after signing in. When a user is in several groups, " +
+					"the group listed first in `homarr_group_order` wins. Leave unset to manage it in the UI.",
+			},
+			"mobile_home_board_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The ID of the board members see after signing in on a mobile device. Leave unset to manage it in the UI.",
+			},
 		},
 	}
 }
 
+// ModifyPlan blocks renames involving built-in groups and warns when members sign in through an
+// identity provider, which may overwrite their memberships
+func (r *GroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state GroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() && !plan.Name.IsUnknown() && plan.Name.ValueString() != state.Name.ValueString() {
+		switch {
+		case IsReservedGroup(state.Name.ValueString()):
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Built-in Group Cannot Be Renamed",
+				fmt.Sprintf("The %q group is built into Homarr, which looks it up by name, so it can't be renamed. "+
+					"Keep name = %q, or remove the resource from the configuration to stop managing the group.", state.Name.ValueString(), state.Name.ValueString()),
+			)
+			return
+		case IsReservedGroup(plan.Name.ValueString()):
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Reserved Group Name",
+				fmt.Sprintf("The name %q belongs to a group built into Homarr. Use a different name, or declare a separate "+
+					"homarr_group resource with this name to manage the built-in group.", plan.Name.ValueString()),
+			)
+			return
+		}
+	}
+
+	if r.client == nil || r.client.SessionToken == "" || plan.Members.IsNull() || plan.Members.IsUnknown() || plan.Members.Equal(state.Members) {
+		return
+	}
+
+	var memberIDs []string
+	resp.Diagnostics.Append(plan.Members.ElementsAs(ctx, &memberIDs, false)...)
+	resp.Diagnostics.Append(warnExternallyManagedMembers(r.client, plan.Name.ValueString(), memberIDs, path.Root("members"))...)
+}
+
 func (r *GroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -85,7 +163,14 @@ func (r *GroupResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	created, err := r.client.CreateGroup(data.Name.ValueString())
+	var created *Group
+	var err error
+	if IsReservedGroup(data.Name.ValueString()) {
+		// Built-in groups always exist and are adopted instead
+		created, err = r.client.GetGroupByName(data.Name.ValueString())
+	} else {
+		created, err = r.client.CreateGroup(data.Name.ValueString())
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create group: %s", err))
 		return
@@ -94,7 +179,24 @@ func (r *GroupResource) Create(ctx context.Context, req resource.CreateRequest,
 	data.ID = types.StringValue(created.ID)
 	data.Name = types.StringValue(created.Name)
 
+	// Save the ID first so a failed membership change does not leave an untracked group
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if !data.Members.IsNull() {
+		resp.Diagnostics.Append(r.syncMembers(ctx, created.ID, data.Members)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !data.Permissions.IsNull() {
+		resp.Diagnostics.Append(r.savePermissions(ctx, created.ID, data.Permissions)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.saveSettings(created.ID, data)...)
 }
 
 func (r *GroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -111,6 +213,10 @@ func (r *GroupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	group, err := r.client.GetGroup(data.ID.ValueString())
+	if IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read group: %s", err))
 		return
@@ -118,6 +224,35 @@ func (r *GroupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	data.Name = types.StringValue(group.Name)
 
+	// Only report drift of the settings managed by this resource
+	if !data.Members.IsNull() || !data.Permissions.IsNull() || !data.HomeBoardID.IsNull() || !data.MobileHomeBoardID.IsNull() {
+		detail, err := r.client.GetGroupDetail(group.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read group details: %s", err))
+			return
+		}
+
+		var diags diag.Diagnostics
+		if !data.Members.IsNull() {
+			memberIDs := make([]string, 0, len(detail.Members))
+			for _, m := range detail.Members {
+				memberIDs = append(memberIDs, m.ID)
+			}
+			data.Members, diags = types.SetValueFrom(ctx, types.StringType, memberIDs)
+			resp.Diagnostics.Append(diags...)
+		}
+		if !data.Permissions.IsNull() {
+			data.Permissions, diags = types.SetValueFrom(ctx, types.StringType, append([]string{}, detail.Permissions...))
+			resp.Diagnostics.Append(diags...)
+		}
+		if !data.HomeBoardID.IsNull() {
+			data.HomeBoardID = types.StringPointerValue(detail.HomeBoardID)
+		}
+		if !data.MobileHomeBoardID.IsNull() {
+			data.MobileHomeBoardID = types.StringPointerValue(detail.MobileHomeBoardID)
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -134,7 +269,14 @@ func (r *GroupResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	updated, err := r.client.UpdateGroup(data.ID.ValueString(), data.Name.ValueString())
+	var updated *Group
+	var err error
+	if IsReservedGroup(data.Name.ValueString()) {
+		// Built-in groups can't be renamed, and ModifyPlan keeps their name unchanged
+		updated, err = r.client.GetGroup(data.ID.ValueString())
+	} else {
+		updated, err = r.client.UpdateGroup(data.ID.ValueString(), data.Name.ValueString())
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update group: %s", err))
 		return
@@ -142,6 +284,25 @@ func (r *GroupResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	data.Name = types.StringValue(updated.Name)
 
+	if !data.Members.IsNull() {
+		resp.Diagnostics.Append(r.syncMembers(ctx, updated.ID, data.Members)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !data.Permissions.IsNull() {
+		resp.Diagnostics.Append(r.savePermissions(ctx, updated.ID, data.Permissions)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.saveSettings(updated.ID, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -158,6 +319,15 @@ func (r *GroupResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	if IsReservedGroup(data.Name.ValueString()) {
+		resp.Diagnostics.AddWarning(
+			"Built-in Group Not Deleted",
+			fmt.Sprintf("The %q group is built into Homarr and can't be deleted. It was removed from the Terraform state only; "+
+				"its members, permissions and home boards are unchanged.", data.Name.ValueString()),
+		)
+		return
+	}
+
 	err := r.client.DeleteGroup(data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete group: %s", err))
@@ -165,6 +335,116 @@ func (r *GroupResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 }
 
+// syncMembers adds and removes members until the group contains exactly the planned users
+func (r *GroupResource) syncMembers(ctx context.Context, groupID string, planned types.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var plannedIDs []string
+	diags.Append(planned.ElementsAs(ctx, &plannedIDs, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	members, err := r.client.GetGroupMembers(groupID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read group members: %s", err))
+		return diags
+	}
+
+	current := make(map[string]bool, len(members))
+	for _, m := range members {
+		current[m.ID] = true
+	}
+	wanted := make(map[string]bool, len(plannedIDs))
+	for _, id := range plannedIDs {
+		wanted[id] = true
+	}
+
+	for _, id := range plannedIDs {
+		if current[id] {
+			continue
+		}
+		if err := r.client.AddGroupMember(groupID, id); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to add user %s to group: %s", id, err))
+			return diags
+		}
+	}
+
+	for _, m := range members {
+		if wanted[m.ID] {
+			continue
+		}
+		if err := r.client.RemoveGroupMember(groupID, m.ID); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to remove user %s from group: %s", m.Name, err))
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// savePermissions replaces the global permissions of the group with the planned ones
+func (r *GroupResource) savePermissions(ctx context.Context, groupID string, planned types.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	permissions := []string{}
+	diags.Append(planned.ElementsAs(ctx, &permissions, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if err := r.client.SaveGroupPermissions(groupID, permissions); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to save group permissions: %s", err))
+	}
+
+	return diags
+}
+
+// saveSettings sets the configured home boards of the group, leaving unconfigured ones unchanged
+func (r *GroupResource) saveSettings(groupID string, data GroupResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.HomeBoardID.IsNull() && data.MobileHomeBoardID.IsNull() {
+		return diags
+	}
+
+	settings := GroupSettings{
+		HomeBoardID:       data.HomeBoardID.ValueStringPointer(),
+		MobileHomeBoardID: data.MobileHomeBoardID.ValueStringPointer(),
+	}
+	if err := r.client.SaveGroupSettings(groupID, settings); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to save group home boards: %s", err))
+	}
+
+	return diags
+}
+
+// warnExternallyManagedMembers warns about users whose group memberships Homarr
+// synchronizes from their OIDC or LDAP provider on every sign-in
+func warnExternallyManagedMembers(client *HomarrClient, groupName string, userIDs []string, attributePath path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, id := range userIDs {
+		user, err := client.GetUser(id)
+		if err != nil {
+			// Unknown users are reported when the membership is applied
+			continue
+		}
+		if user.Provider == UserProviderCredentials {
+			continue
+		}
+
+		diags.AddAttributeWarning(
+			attributePath,
+			"Membership Managed by Identity Provider",
+			fmt.Sprintf("User %q signs in with %s. Homarr updates the group memberships of %s users from the identity provider "+
+				"on every sign-in, so membership in group %q may be changed outside of Terraform.", user.Name, user.Provider, user.Provider, groupName),
+		)
+	}
+
+	return diags
+}
+
 func (r *GroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
 }
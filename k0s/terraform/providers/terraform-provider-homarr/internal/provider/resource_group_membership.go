@@ -0,0 +1,267 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GroupMembershipResource{}
+var _ resource.ResourceWithImportState = &GroupMembershipResource{}
+var _ resource.ResourceWithValidateConfig = &GroupMembershipResource{}
+var _ resource.ResourceWithModifyPlan = &GroupMembershipResource{}
+
+func NewGroupMembershipResource() resource.Resource {
+	return &GroupMembershipResource{}
+}
+
+// GroupMembershipResource defines the resource implementation.
+type GroupMembershipResource struct {
+	client *HomarrClient
+}
+
+// GroupMembershipResourceModel describes the resource data model.
+type GroupMembershipResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	GroupID  types.String `tfsdk:"group_id"`
+	UserID   types.String `tfsdk:"user_id"`
+	Username types.String `tfsdk:"username"`
+}
+
+func (r *GroupMembershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_membership"
+}
+
+func (r *GroupMembershipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Adds a single user to a group. Don't combine with the `members` attribute of `homarr_group` for the same group. " +
+			"Requires session_token authentication.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the membership, `<group_id>/<user_id>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the group.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The ID of the user. Exactly one of `user_id` or `username` must be set.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"username": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The username of the user. Exactly one of `user_id` or `username` must be set.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *GroupMembershipResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data GroupMembershipResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.UserID.IsUnknown() || data.Username.IsUnknown() {
+		return
+	}
+
+	if data.UserID.IsNull() == data.Username.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("user_id"),
+			"Invalid User Reference",
+			"Exactly one of user_id or username must be set.",
+		)
+	}
+}
+
+// ModifyPlan warns when the user signs in through an identity provider, which may overwrite the membership
+func (r *GroupMembershipResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() || r.client == nil || r.client.SessionToken == "" {
+		return
+	}
+
+	var data GroupMembershipResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.GroupID.IsUnknown() {
+		return
+	}
+
+	user, err := r.lookupUser(data)
+	if err != nil {
+		return
+	}
+
+	groupName := data.GroupID.ValueString()
+	if group, err := r.client.GetGroup(data.GroupID.ValueString()); err == nil {
+		groupName = group.Name
+	}
+
+	resp.Diagnostics.Append(warnExternallyManagedMembers(r.client, groupName, []string{user.ID}, path.Root("user_id"))...)
+}
+
+func (r *GroupMembershipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*HomarrClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *HomarrClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *GroupMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GroupMembershipResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.SessionToken == "" {
+		resp.Diagnostics.AddError("Missing Session Token", "Groups require session_token authentication. Please configure session_token in the provider.")
+		return
+	}
+
+	user, err := r.lookupUser(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find user: %s", err))
+		return
+	}
+
+	if err := r.client.AddGroupMember(data.GroupID.ValueString(), user.ID); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add user to group: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(data.GroupID.ValueString() + "/" + user.ID)
+	data.UserID = types.StringValue(user.ID)
+	data.Username = types.StringValue(user.Name)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GroupMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GroupMembershipResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.SessionToken == "" {
+		resp.Diagnostics.AddError("Missing Session Token", "Groups require session_token authentication. Please configure session_token in the provider.")
+		return
+	}
+
+	members, err := r.client.GetGroupMembers(data.GroupID.ValueString())
+	if IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read group members: %s", err))
+		return
+	}
+
+	for _, m := range members {
+		if m.ID == data.UserID.ValueString() {
+			data.Username = types.StringValue(m.Name)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	}
+
+	// The user was removed from the group outside of Terraform
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *GroupMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All configurable attributes require replacement; only computed values can change here
+	var data GroupMembershipResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GroupMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data GroupMembershipResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.SessionToken == "" {
+		resp.Diagnostics.AddError("Missing Session Token", "Groups require session_token authentication. Please configure session_token in the provider.")
+		return
+	}
+
+	err := r.client.RemoveGroupMember(data.GroupID.ValueString(), data.UserID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove user from group: %s", err))
+		return
+	}
+}
+
+func (r *GroupMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	groupID, userID, ok := strings.Cut(req.ID, "/")
+	if !ok || groupID == "" || userID == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected an import ID of the form <group_id>/<user_id>, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_id"), groupID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), userID)...)
+}
+
+// lookupUser resolves the configured user ID or username
+func (r *GroupMembershipResource) lookupUser(data GroupMembershipResourceModel) (*User, error) {
+	if !data.UserID.IsNull() && !data.UserID.IsUnknown() {
+		return r.client.GetUser(data.UserID.ValueString())
+	}
+	return r.client.GetUserByName(data.Username.ValueString())
+}
@@ -0,0 +1,227 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// groupOrderID is the ID of the singleton group order
+const groupOrderID = "group_order"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GroupOrderResource{}
+var _ resource.ResourceWithImportState = &GroupOrderResource{}
+
+func NewGroupOrderResource() resource.Resource {
+	return &GroupOrderResource{}
+}
+
+// GroupOrderResource defines the resource implementation.
+type GroupOrderResource struct {
+	client *HomarrClient
+}
+
+// GroupOrderResourceModel describes the resource data model.
+type GroupOrderResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	GroupIDs types.List   `tfsdk:"group_ids"`
+}
+
+func (r *GroupOrderResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_order"
+}
+
+func (r *GroupOrderResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the order of the groups in Homarr. When a user is in several groups, the settings of the group listed first, " +
+			"such as its home board, take precedence. This is a singleton resource: declare it at most once. " +
+			"Destroying it leaves the order unchanged. Requires session_token authentication.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the group order (always `group_order`).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group_ids": schema.ListAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				MarkdownDescription: "The IDs of all groups, highest precedence first. The built-in `everyone` group always comes last and must not be listed. " +
+					"Groups created outside of Terraform show up as drift.",
+			},
+		},
+	}
+}
+
+func (r *GroupOrderResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*HomarrClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *HomarrClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *GroupOrderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GroupOrderResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.SessionToken == "" {
+		resp.Diagnostics.AddError("Missing Session Token", "Groups require session_token authentication. Please configure session_token in the provider.")
+		return
+	}
+
+	resp.Diagnostics.Append(r.savePositions(ctx, data.GroupIDs)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(groupOrderID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GroupOrderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GroupOrderResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.SessionToken == "" {
+		resp.Diagnostics.AddError("Missing Session Token", "Groups require session_token authentication. Please configure session_token in the provider.")
+		return
+	}
+
+	groupIDs, err := r.orderedGroupIDs()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read groups: %s", err))
+		return
+	}
+
+	var diags diag.Diagnostics
+	data.ID = types.StringValue(groupOrderID)
+	data.GroupIDs, diags = types.ListValueFrom(ctx, types.StringType, groupIDs)
+	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GroupOrderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data GroupOrderResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.SessionToken == "" {
+		resp.Diagnostics.AddError("Missing Session Token", "Groups require session_token authentication. Please configure session_token in the provider.")
+		return
+	}
+
+	resp.Diagnostics.Append(r.savePositions(ctx, data.GroupIDs)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GroupOrderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Groups always have an order; removing the resource only stops managing it
+}
+
+func (r *GroupOrderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), groupOrderID)...)
+}
+
+// savePositions saves the planned group order
+func (r *GroupOrderResource) savePositions(ctx context.Context, planned types.List) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	groupIDs := []string{}
+	diags.Append(planned.ElementsAs(ctx, &groupIDs, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	groups, err := r.client.GetGroups()
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read groups: %s", err))
+		return diags
+	}
+
+	names := make(map[string]string, len(groups))
+	for _, g := range groups {
+		names[g.ID] = g.Name
+	}
+
+	seen := make(map[string]bool, len(groupIDs))
+	for i, id := range groupIDs {
+		name, ok := names[id]
+		switch {
+		case !ok:
+			diags.AddAttributeError(path.Root("group_ids").AtListIndex(i), "Group Not Found", fmt.Sprintf("No group with ID %q exists.", id))
+		case name == EveryoneGroupName:
+			diags.AddAttributeError(path.Root("group_ids").AtListIndex(i), "Invalid Group Order",
+				fmt.Sprintf("The %q group always comes last and can't be ordered.", EveryoneGroupName))
+		case seen[id]:
+			diags.AddAttributeError(path.Root("group_ids").AtListIndex(i), "Invalid Group Order", fmt.Sprintf("Group %q is listed more than once.", name))
+		}
+		seen[id] = true
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	if err := r.client.SaveGroupPositions(groupIDs); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to save group order: %s", err))
+	}
+
+	return diags
+}
+
+// orderedGroupIDs returns the IDs of all groups except everyone, highest precedence first
+func (r *GroupOrderResource) orderedGroupIDs() ([]string, error) {
+	groups, err := r.client.GetGroups()
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(groups, func(i, j int) bool { return groups[i].Position < groups[j].Position })
+
+	groupIDs := make([]string, 0, len(groups))
+	for _, g := range groups {
+		if g.Name == EveryoneGroupName {
+			continue
+		}
+		groupIDs = append(groupIDs, g.ID)
+	}
+
+	return groupIDs, nil
+}
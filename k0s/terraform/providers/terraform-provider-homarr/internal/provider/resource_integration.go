@@ -2,19 +2,34 @@ package provider
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// integrationSecretsPrivateKey is the private state key recording the secrets last applied by Terraform
+const integrationSecretsPrivateKey = "secrets"
+
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &IntegrationResource{}
 var _ resource.ResourceWithImportState = &IntegrationResource{}
+var _ resource.ResourceWithValidateConfig = &IntegrationResource{}
+var _ resource.ResourceWithModifyPlan = &IntegrationResource{}
 
 func NewIntegrationResource() resource.Resource {
 	return &IntegrationResource{}
@@ -27,11 +42,21 @@ type IntegrationResource struct {
 
 // IntegrationResourceModel describes the resource data model.
 type IntegrationResourceModel struct {
-	ID     types.String `tfsdk:"id"`
-	Name   types.String `tfsdk:"name"`
-	Kind   types.String `tfsdk:"kind"`
-	URL    types.String `tfsdk:"url"`
-	APIKey types.String `tfsdk:"api_key"`
+	ID      types.String `tfsdk:"id"`
+	Name    types.String `tfsdk:"name"`
+	Kind    types.String `tfsdk:"kind"`
+	URL     types.String `tfsdk:"url"`
+	AppID   types.String `tfsdk:"app_id"`
+	APIKey  types.String `tfsdk:"api_key"`
+	Secrets types.Map    `tfsdk:"secrets"`
+
+	APIKeyWO         types.String `tfsdk:"api_key_wo"`
+	SecretsWO        types.Map    `tfsdk:"secrets_wo"`
+	SecretsWOVersion types.Int64  `tfsdk:"secrets_wo_version"`
+
+	UserPermissions  types.Map  `tfsdk:"user_permissions"`
+	GroupPermissions types.Map  `tfsdk:"group_permissions"`
+	VerifyConnection types.Bool `tfsdk:"verify_connection"`
 }
 
 func (r *IntegrationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -56,7 +81,7 @@ func (r *IntegrationResource) Schema(ctx context.Context, req resource.SchemaReq
 			},
 			"kind": schema.StringAttribute{
 				Required:            true,
-				MarkdownDescription: "The type of integration (e.g., sonarr, radarr, jellyfin, plex, homeAssistant, piHole, adGuardHome, etc.).",
+				MarkdownDescription: "The type of integration: " + markdownList(integrationKinds()) + ". Checked at plan time together with the secrets the kind requires.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -65,15 +90,223 @@ func (r *IntegrationResource) Schema(ctx context.Context, req resource.SchemaReq
 				Required:            true,
 				MarkdownDescription: "The URL of the integration service.",
 			},
+			"app_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The ID of the app the integration belongs to. Homarr uses it to show the integration's status on the app and link to it.",
+			},
 			"api_key": schema.StringAttribute{
 				Optional:            true,
 				Sensitive:           true,
-				MarkdownDescription: "The API key for the integration (if required by the service).",
+				MarkdownDescription: "The API key for the integration (if required by the service). Shorthand for `secrets = { apiKey = ... }`.",
+			},
+			"secrets": schema.MapAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+				MarkdownDescription: "The credentials for the integration, keyed by secret kind: " + markdownList(IntegrationSecretKinds) + ". " +
+					"For example, qBittorrent needs `username` and `password`, and Proxmox needs `username`, `tokenId`, `apiKey` and `realm`.",
+				Validators: []validator.Map{
+					mapKeysOneOf(IntegrationSecretKinds...),
+				},
+			},
+			"api_key_wo": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+				MarkdownDescription: "Write-only alternative to `api_key`: sent to Homarr but never stored in state. " +
+					"Change `secrets_wo_version` to apply a new value. Requires Terraform >= 1.11.",
+			},
+			"secrets_wo": schema.MapAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				ElementType: types.StringType,
+				MarkdownDescription: "Write-only alternative to `secrets`: sent to Homarr but never stored in state. " +
+					"Change `secrets_wo_version` to apply new values. Requires Terraform >= 1.11.",
+				Validators: []validator.Map{
+					mapKeysOneOf(IntegrationSecretKinds...),
+				},
+			},
+			"secrets_wo_version": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Change this value to apply new values of `api_key_wo` and `secrets_wo` to an existing integration.",
+			},
+			"user_permissions": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				MarkdownDescription: "Access to the integration, keyed by user ID, with the same values as `group_permissions`. " +
+					"When set, the map is authoritative: grants added elsewhere show up as drift and are removed. Leave unset to manage user access in the UI.",
+				Validators: []validator.Map{
+					mapValuesOneOf(IntegrationPermissionValues...),
+				},
+			},
+			"group_permissions": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				MarkdownDescription: "Access to the integration, keyed by group ID. Values are `use` (show its data in widgets), " +
+					"`interact` (also trigger actions such as pausing downloads) or `full`. When set, the map is authoritative: " +
+					"grants added elsewhere show up as drift and are removed. Leave unset to manage group access in the UI.",
+				Validators: []validator.Map{
+					mapValuesOneOf(IntegrationPermissionValues...),
+				},
+			},
+			"verify_connection": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Test the connection to the service during plan whenever the integration is created or changed, " +
+					"so unreachable services and rejected credentials are reported before apply. Defaults to `false`.",
 			},
 		},
 	}
 }
 
+func (r *IntegrationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data IntegrationResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.SecretsWOVersion.IsNull() && data.APIKeyWO.IsNull() && data.SecretsWO.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("secrets_wo_version"),
+			"Missing Write-Only Secrets",
+			"secrets_wo_version has no effect without api_key_wo or secrets_wo.",
+		)
+	}
+
+	if data.Kind.IsUnknown() || data.Secrets.IsUnknown() || data.SecretsWO.IsUnknown() {
+		return
+	}
+
+	// Every secret kind may only be configured once, in one of the four attributes
+	sources := []struct {
+		attribute string
+		kinds     []string
+	}{
+		{"secrets", mapKeys(data.Secrets)},
+		// Unknown single values are assumed to be set
+		{"api_key", singleSecretKind(data.APIKey, IntegrationSecretAPIKey)},
+		{"secrets_wo", mapKeys(data.SecretsWO)},
+		{"api_key_wo", singleSecretKind(data.APIKeyWO, IntegrationSecretAPIKey)},
+	}
+	configuredIn := map[string]string{}
+	var secretKinds []string
+	for _, source := range sources {
+		for _, secretKind := range source.kinds {
+			if other, ok := configuredIn[secretKind]; ok {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(source.attribute),
+					"Conflicting Secrets",
+					fmt.Sprintf("The %s secret is set in both %s and %s. Set it only once.", secretKind, other, source.attribute),
+				)
+				return
+			}
+			configuredIn[secretKind] = source.attribute
+			secretKinds = append(secretKinds, secretKind)
+		}
+	}
+	sort.Strings(secretKinds)
+
+	kind := data.Kind.ValueString()
+	definition, ok := findIntegrationDefinition(kind)
+	if !ok {
+		// Newer Homarr releases may support the kind, so the secrets can't be checked either
+		resp.Diagnostics.Append(unknownIntegrationKindWarning(path.Root("kind"), kind)...)
+		return
+	}
+
+	if !definition.acceptsSecrets(secretKinds) {
+		configured := "no credentials"
+		if len(secretKinds) > 0 {
+			configured = strings.Join(secretKinds, " + ")
+		}
+		resp.Diagnostics.AddAttributeError(
+			path.Root("secrets"),
+			"Invalid Integration Secrets",
+			fmt.Sprintf("Integrations of kind %q require %s, but %s is configured.", kind, definition.describeSecretKinds(), configured),
+		)
+	}
+}
+
+// unknownIntegrationKindWarning warns about a kind missing from the catalogue, suggesting the closest known kind
+func unknownIntegrationKindWarning(p path.Path, kind string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	detail := fmt.Sprintf("The integration kind %q isn't in the catalogue of Homarr %s known to this provider.", kind, IntegrationCatalogueVersion)
+	if suggestion := closestIntegrationKind(kind); suggestion != "" {
+		detail += fmt.Sprintf(" Did you mean %q?", suggestion)
+	}
+	detail += " If the kind was added in a later Homarr release, ignore this warning; Homarr checks the kind and its secrets on apply." +
+		"\n\nKnown kinds: " + strings.Join(integrationKinds(), ", ")
+	diags.AddAttributeWarning(p, "Unknown Integration Kind", detail)
+
+	return diags
+}
+
+// ModifyPlan tests the connection to the service when verify_connection is enabled
+func (r *IntegrationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil || r.client.SessionToken == "" {
+		return
+	}
+
+	var plan IntegrationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || !plan.VerifyConnection.ValueBool() {
+		return
+	}
+
+	// Only test when something changes, so unchanged integrations don't slow down every plan
+	if !req.State.Raw.IsNull() && req.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+
+	var config IntegrationResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	known := !plan.Kind.IsUnknown() && !plan.URL.IsUnknown() && !plan.APIKey.IsUnknown() && !plan.Secrets.IsUnknown() &&
+		!config.APIKeyWO.IsUnknown() && !config.SecretsWO.IsUnknown()
+	for _, value := range plan.Secrets.Elements() {
+		known = known && !value.IsUnknown()
+	}
+	for _, value := range config.SecretsWO.Elements() {
+		known = known && !value.IsUnknown()
+	}
+	if !known {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("verify_connection"),
+			"Connection Not Verified",
+			"The URL or credentials of the integration are not known until apply, so the connection could not be tested during plan.",
+		)
+		return
+	}
+
+	secrets, diags := integrationSecrets(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	writeOnly, diags := writeOnlySecrets(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := TestIntegrationInput{
+		Kind:    plan.Kind.ValueString(),
+		URL:     plan.URL.ValueString(),
+		Secrets: mergeSecrets(secrets, writeOnly),
+	}
+	if !req.State.Raw.IsNull() {
+		// Secrets already stored in Homarr are used for the kinds not configured here
+		input.ID = plan.ID.ValueStringPointer()
+	}
+
+	if err := r.client.TestIntegrationConnection(input); err != nil {
+		resp.Diagnostics.Append(integrationConnectionDiagnostics(err, path.Root("url"), path.Root("secrets"))...)
+	}
+}
+
 func (r *IntegrationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -104,19 +337,20 @@ func (r *IntegrationResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
-	secrets := make([]IntegrationSecret, 0)
-	if !data.APIKey.IsNull() && data.APIKey.ValueString() != "" {
-		secrets = append(secrets, IntegrationSecret{
-			Kind:  "apiKey",
-			Value: data.APIKey.ValueString(),
-		})
+	secrets, diags := integrationSecrets(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	writeOnly, diags := writeOnlySecrets(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	input := CreateIntegrationInput{
 		Name:                        data.Name.ValueString(),
 		Kind:                        data.Kind.ValueString(),
 		URL:                         data.URL.ValueString(),
-		Secrets:                     secrets,
+		Secrets:                     mergeSecrets(secrets, writeOnly),
+		AppID:                       data.AppID.ValueStringPointer(),
 		AttemptSearchEngineCreation: false,
 	}
 
@@ -131,7 +365,17 @@ func (r *IntegrationResource) Create(ctx context.Context, req resource.CreateReq
 	data.Kind = types.StringValue(created.Kind)
 	data.URL = types.StringValue(created.URL)
 
+	// Save the ID first so failing to grant access does not leave an untracked integration
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	resp.Diagnostics.Append(r.recordSecrets(ctx, resp.Private, created.ID, secrets, writeOnly)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.UserPermissions.IsNull() || !data.GroupPermissions.IsNull() {
+		resp.Diagnostics.Append(r.savePermissions(ctx, created.ID, data)...)
+	}
 }
 
 func (r *IntegrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -156,7 +400,33 @@ func (r *IntegrationResource) Read(ctx context.Context, req resource.ReadRequest
 	data.Name = types.StringValue(integration.Name)
 	data.Kind = types.StringValue(integration.Kind)
 	data.URL = types.StringValue(integration.URL)
-	// Note: API key is write-only, we don't read it back
+	data.AppID = types.StringPointerValue(integration.AppID)
+	// Note: API key is write-only, we don't read it back. Secrets changed in the UI are
+	// detected by their timestamps instead, and dropped from state to plan re-applying them.
+	resp.Diagnostics.Append(r.detectRotatedSecrets(ctx, req.Private, resp.Private, &data, integration)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only report access drift when it is managed by this resource
+	if !data.UserPermissions.IsNull() || !data.GroupPermissions.IsNull() {
+		permissions, err := r.client.GetIntegrationPermissions(integration.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read integration permissions: %s", err))
+			return
+		}
+
+		users, groups := grantedIntegrationPermissions(permissions)
+		var diags diag.Diagnostics
+		if !data.UserPermissions.IsNull() {
+			data.UserPermissions, diags = types.MapValueFrom(ctx, types.StringType, users)
+			resp.Diagnostics.Append(diags...)
+		}
+		if !data.GroupPermissions.IsNull() {
+			data.GroupPermissions, diags = types.MapValueFrom(ctx, types.StringType, groups)
+			resp.Diagnostics.Append(diags...)
+		}
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -174,19 +444,21 @@ func (r *IntegrationResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
-	secrets := make([]IntegrationSecret, 0)
-	if !data.APIKey.IsNull() && data.APIKey.ValueString() != "" {
-		secrets = append(secrets, IntegrationSecret{
-			Kind:  "apiKey",
-			Value: data.APIKey.ValueString(),
-		})
+	// Write-only secrets are sent on every update, as Homarr removes secret kinds missing from the update
+	secrets, diags := integrationSecrets(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	writeOnly, diags := writeOnlySecrets(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	input := UpdateIntegrationInput{
 		ID:      data.ID.ValueString(),
 		Name:    data.Name.ValueString(),
 		URL:     data.URL.ValueString(),
-		Secrets: secrets,
+		Secrets: mergeSecrets(secrets, writeOnly),
+		AppID:   data.AppID.ValueStringPointer(),
 	}
 
 	err := r.client.UpdateIntegration(input)
@@ -204,6 +476,16 @@ func (r *IntegrationResource) Update(ctx context.Context, req resource.UpdateReq
 
 	data.Name = types.StringValue(integration.Name)
 	data.URL = types.StringValue(integration.URL)
+	data.AppID = types.StringPointerValue(integration.AppID)
+
+	resp.Diagnostics.Append(writeSecretsPrivate(ctx, resp.Private, secrets, writeOnly, integration)...)
+
+	if !data.UserPermissions.IsNull() || !data.GroupPermissions.IsNull() {
+		resp.Diagnostics.Append(r.savePermissions(ctx, integration.ID, data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -228,6 +510,309 @@ func (r *IntegrationResource) Delete(ctx context.Context, req resource.DeleteReq
 	}
 }
 
+// privateState is implemented by the private state of resource requests and responses
+type privateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// integrationSecretsPrivate records the secrets last applied by Terraform
+type integrationSecretsPrivate struct {
+	// Hash identifies the secret values the timestamps belong to
+	Hash string `json:"hash"`
+	// UpdatedAt is the last change of each secret kind seen in Homarr
+	UpdatedAt map[string]time.Time `json:"updatedAt"`
+	// WriteOnlyKinds are the secret kinds configured through write-only attributes
+	WriteOnlyKinds []string `json:"writeOnlyKinds,omitempty"`
+}
+
+// secretsHash returns a hash of the secret kinds and values.
+// Only secrets stored in state are hashed, so it reveals nothing state doesn't already hold.
+func secretsHash(secrets []IntegrationSecret) string {
+	h := sha256.New()
+	for _, secret := range secrets {
+		fmt.Fprintf(h, "%s=%s\n", secret.Kind, secret.Value)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// recordSecrets reads the integration back and records the applied secrets in private state
+func (r *IntegrationResource) recordSecrets(ctx context.Context, private privateState, integrationID string, secrets, writeOnly []IntegrationSecret) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	integration, err := r.client.GetIntegrationByID(integrationID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read integration: %s", err))
+		return diags
+	}
+
+	return writeSecretsPrivate(ctx, private, secrets, writeOnly, integration)
+}
+
+// writeSecretsPrivate records the applied secrets and their timestamps in Homarr in private state.
+// Only the secrets stored in state are hashed, as write-only values aren't available on read.
+func writeSecretsPrivate(ctx context.Context, private privateState, secrets, writeOnly []IntegrationSecret, integration *Integration) diag.Diagnostics {
+	record := integrationSecretsPrivate{Hash: secretsHash(secrets), UpdatedAt: map[string]time.Time{}}
+	for _, secret := range writeOnly {
+		record.WriteOnlyKinds = append(record.WriteOnlyKinds, secret.Kind)
+	}
+	for _, secret := range integration.Secrets {
+		if secret.UpdatedAt != nil {
+			record.UpdatedAt[secret.Kind] = *secret.UpdatedAt
+		}
+	}
+
+	return setSecretsPrivate(ctx, private, record)
+}
+
+// setSecretsPrivate stores the record in private state
+func setSecretsPrivate(ctx context.Context, private privateState, record integrationSecretsPrivate) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	value, err := json.Marshal(record)
+	if err != nil {
+		diags.AddError("Internal Error", fmt.Sprintf("Unable to encode private state: %s", err))
+		return diags
+	}
+
+	return private.SetKey(ctx, integrationSecretsPrivateKey, value)
+}
+
+// detectRotatedSecrets drops secrets changed or removed outside of Terraform from the state,
+// so the next plan re-applies the configured values
+func (r *IntegrationResource) detectRotatedSecrets(ctx context.Context, prior, private privateState, data *IntegrationResourceModel, integration *Integration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	secrets, d := integrationSecrets(ctx, *data)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	value, d := prior.GetKey(ctx, integrationSecretsPrivateKey)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	var record integrationSecretsPrivate
+	if value == nil || json.Unmarshal(value, &record) != nil || record.Hash != secretsHash(secrets) {
+		// Nothing recorded for these values yet (e.g. after import), so take the current secrets as baseline
+		var writeOnly []IntegrationSecret
+		for _, kind := range record.WriteOnlyKinds {
+			writeOnly = append(writeOnly, IntegrationSecret{Kind: kind})
+		}
+		return writeSecretsPrivate(ctx, private, secrets, writeOnly, integration)
+	}
+
+	current := make(map[string]*time.Time, len(integration.Secrets))
+	for _, secret := range integration.Secrets {
+		current[secret.Kind] = secret.UpdatedAt
+	}
+
+	isRotated := func(kind string) bool {
+		recorded, ok := record.UpdatedAt[kind]
+		updatedAt, exists := current[kind]
+		return !exists || (ok && updatedAt != nil && updatedAt.After(recorded))
+	}
+
+	var rotated []string
+	for _, secret := range secrets {
+		if isRotated(secret.Kind) {
+			rotated = append(rotated, secret.Kind)
+		}
+	}
+	var rotatedWriteOnly []string
+	for _, kind := range record.WriteOnlyKinds {
+		if isRotated(kind) {
+			rotatedWriteOnly = append(rotatedWriteOnly, kind)
+		}
+	}
+
+	if len(rotatedWriteOnly) > 0 {
+		detail := fmt.Sprintf("The write-only %s of integration %q were changed or removed in Homarr since the last apply. ",
+			strings.Join(rotatedWriteOnly, ", "), integration.Name)
+		if data.SecretsWOVersion.IsNull() {
+			detail += "Set secrets_wo_version to let Terraform re-apply write-only secrets."
+
+			// Nothing will re-apply them, so take the values in Homarr as the new baseline to warn only once
+			if record.UpdatedAt == nil {
+				record.UpdatedAt = map[string]time.Time{}
+			}
+			var kinds []string
+			for _, kind := range record.WriteOnlyKinds {
+				if updatedAt, exists := current[kind]; exists {
+					kinds = append(kinds, kind)
+					if updatedAt != nil {
+						record.UpdatedAt[kind] = *updatedAt
+					}
+				}
+			}
+			record.WriteOnlyKinds = kinds
+			diags.Append(setSecretsPrivate(ctx, private, record)...)
+		} else {
+			// Changing the version in state plans an update, which sends the write-only values again
+			data.SecretsWOVersion = types.Int64Null()
+			detail += "The next apply sets them to the configured values again."
+		}
+		diags.AddWarning("Integration Secrets Changed Outside Terraform", detail)
+	}
+
+	if len(rotated) == 0 {
+		return diags
+	}
+
+	elements := data.Secrets.Elements()
+	remaining := make(map[string]attr.Value, len(elements))
+	for kind, element := range elements {
+		if !containsString(rotated, kind) {
+			remaining[kind] = element
+		}
+	}
+	if !data.Secrets.IsNull() {
+		data.Secrets, d = types.MapValue(types.StringType, remaining)
+		diags.Append(d...)
+	}
+	if containsString(rotated, IntegrationSecretAPIKey) && !data.APIKey.IsNull() {
+		data.APIKey = types.StringNull()
+	}
+
+	diags.AddWarning(
+		"Integration Secrets Changed Outside Terraform",
+		fmt.Sprintf("The %s of integration %q were changed or removed in Homarr since the last apply. "+
+			"The next apply sets them to the configured values again.", strings.Join(rotated, ", "), integration.Name),
+	)
+
+	return diags
+}
+
+// integrationSecrets collects the configured secrets, ordered by kind
+func integrationSecrets(ctx context.Context, data IntegrationResourceModel) ([]IntegrationSecret, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	values := map[string]string{}
+	if !data.Secrets.IsNull() {
+		diags.Append(data.Secrets.ElementsAs(ctx, &values, false)...)
+	}
+	if !data.APIKey.IsNull() && data.APIKey.ValueString() != "" {
+		values[IntegrationSecretAPIKey] = data.APIKey.ValueString()
+	}
+
+	return secretsFromMap(values), diags
+}
+
+// writeOnlySecrets collects the write-only secrets from the configuration
+func writeOnlySecrets(ctx context.Context, config tfsdk.Config) ([]IntegrationSecret, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var apiKey types.String
+	var secrets types.Map
+	diags.Append(config.GetAttribute(ctx, path.Root("api_key_wo"), &apiKey)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("secrets_wo"), &secrets)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	values := map[string]string{}
+	if !secrets.IsNull() {
+		diags.Append(secrets.ElementsAs(ctx, &values, false)...)
+	}
+	if !apiKey.IsNull() && apiKey.ValueString() != "" {
+		values[IntegrationSecretAPIKey] = apiKey.ValueString()
+	}
+
+	return secretsFromMap(values), diags
+}
+
+// mergeSecrets combines the secrets stored in state with the write-only ones, ordered by kind
+func mergeSecrets(secrets, writeOnly []IntegrationSecret) []IntegrationSecret {
+	merged := append(append([]IntegrationSecret{}, secrets...), writeOnly...)
+	sort.Slice(merged, func(i, j int) bool { return merged[i].Kind < merged[j].Kind })
+
+	return merged
+}
+
+// mapKeys returns the keys of a map value
+func mapKeys(m types.Map) []string {
+	keys := make([]string, 0, len(m.Elements()))
+	for key := range m.Elements() {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// singleSecretKind returns the kind of a secret configured through a single attribute, if it is set
+func singleSecretKind(value types.String, kind string) []string {
+	if value.IsNull() || (!value.IsUnknown() && value.ValueString() == "") {
+		return nil
+	}
+	return []string{kind}
+}
+
+// secretsFromMap converts a secret kind => value map into API secrets, ordered by kind
+func secretsFromMap(values map[string]string) []IntegrationSecret {
+	secrets := make([]IntegrationSecret, 0, len(values))
+	for kind, value := range values {
+		secrets = append(secrets, IntegrationSecret{Kind: kind, Value: value})
+	}
+	sort.Slice(secrets, func(i, j int) bool { return secrets[i].Kind < secrets[j].Kind })
+
+	return secrets
+}
+
+// savePermissions replaces the user and group permissions of the integration with the planned ones.
+// Homarr saves both in one call, so the grants of an unmanaged side are read and saved unchanged.
+func (r *IntegrationResource) savePermissions(ctx context.Context, integrationID string, data IntegrationResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var users, groups map[string]string
+	if data.UserPermissions.IsNull() || data.GroupPermissions.IsNull() {
+		current, err := r.client.GetIntegrationPermissions(integrationID)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to read integration permissions: %s", err))
+			return diags
+		}
+		users, groups = grantedIntegrationPermissions(current)
+	}
+	if !data.UserPermissions.IsNull() {
+		diags.Append(data.UserPermissions.ElementsAs(ctx, &users, false)...)
+	}
+	if !data.GroupPermissions.IsNull() {
+		diags.Append(data.GroupPermissions.ElementsAs(ctx, &groups, false)...)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	permissions := make([]IntegrationPermission, 0, len(users)+len(groups))
+	for userID, permission := range users {
+		permissions = append(permissions, IntegrationPermission{PrincipalID: userID, Permission: permission})
+	}
+	for groupID, permission := range groups {
+		permissions = append(permissions, IntegrationPermission{PrincipalID: groupID, Permission: permission})
+	}
+	sort.Slice(permissions, func(i, j int) bool { return permissions[i].PrincipalID < permissions[j].PrincipalID })
+
+	if err := r.client.SaveIntegrationPermissions(integrationID, permissions); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to save integration permissions: %s", err))
+	}
+
+	return diags
+}
+
+// grantedIntegrationPermissions returns the permissions granted on an integration by user and group ID
+func grantedIntegrationPermissions(permissions *IntegrationPermissions) (users, groups map[string]string) {
+	users = make(map[string]string, len(permissions.Users))
+	for _, p := range permissions.Users {
+		users[p.User.ID] = p.Permission
+	}
+	groups = make(map[string]string, len(permissions.Groups))
+	for _, p := range permissions.Groups {
+		groups[p.Group.ID] = p.Permission
+	}
+	return users, groups
+}
+
 func (r *IntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
 }
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// memoryPrivateState is a privateState kept in memory
+type memoryPrivateState map[string][]byte
+
+func (s memoryPrivateState) GetKey(_ context.Context, key string) ([]byte, diag.Diagnostics) {
+	return s[key], nil
+}
+
+func (s memoryPrivateState) SetKey(_ context.Context, key string, value []byte) diag.Diagnostics {
+	s[key] = value
+	return nil
+}
+
+func TestSecretsHash(t *testing.T) {
+	secrets := []IntegrationSecret{{Kind: "password", Value: "hunter2"}, {Kind: "username", Value: "admin"}}
+
+	if secretsHash(secrets) != secretsHash(secrets) {
+		t.Error("expected the same hash for the same secrets")
+	}
+	if secretsHash(secrets) == secretsHash([]IntegrationSecret{{Kind: "password", Value: "hunter3"}, {Kind: "username", Value: "admin"}}) {
+		t.Error("expected a different hash for a changed value")
+	}
+	if secretsHash(secrets) == secretsHash(secrets[1:]) {
+		t.Error("expected a different hash for a removed secret")
+	}
+}
+
+func TestDetectRotatedWriteOnlySecrets(t *testing.T) {
+	ctx := context.Background()
+	applied := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	rotated := applied.Add(time.Hour)
+
+	tests := []struct {
+		name      string
+		secrets   []IntegrationSecret
+		wantKinds []string
+	}{
+		{name: "changed", secrets: []IntegrationSecret{{Kind: "password", UpdatedAt: &rotated}}, wantKinds: []string{"password"}},
+		{name: "removed", secrets: nil, wantKinds: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			private := memoryPrivateState{}
+			diags := writeSecretsPrivate(ctx, private, nil, []IntegrationSecret{{Kind: "password"}},
+				&Integration{Secrets: []IntegrationSecret{{Kind: "password", UpdatedAt: &applied}}})
+			if diags.HasError() {
+				t.Fatalf("write: %v", diags)
+			}
+
+			integration := &Integration{Name: "router", Secrets: tt.secrets}
+			r := &IntegrationResource{}
+
+			// Without secrets_wo_version nothing re-applies the secrets, so only the first read warns
+			for i, wantWarning := range []bool{true, false} {
+				var data IntegrationResourceModel
+				diags := r.detectRotatedSecrets(ctx, private, private, &data, integration)
+				if diags.HasError() {
+					t.Fatalf("read %d: %v", i, diags)
+				}
+				if got := diags.WarningsCount() > 0; got != wantWarning {
+					t.Errorf("read %d: expected warning %t, got %v", i, wantWarning, diags)
+				}
+			}
+
+			var record integrationSecretsPrivate
+			if err := json.Unmarshal(private[integrationSecretsPrivateKey], &record); err != nil {
+				t.Fatalf("decode: %s", err)
+			}
+			if !reflect.DeepEqual(record.WriteOnlyKinds, tt.wantKinds) {
+				t.Errorf("write-only kinds: got %v, want %v", record.WriteOnlyKinds, tt.wantKinds)
+			}
+		})
+	}
+}
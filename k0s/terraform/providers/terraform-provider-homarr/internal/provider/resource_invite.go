@@ -0,0 +1,338 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &InviteResource{}
+var _ resource.ResourceWithModifyPlan = &InviteResource{}
+var _ resource.ResourceWithValidateConfig = &InviteResource{}
+
+func NewInviteResource() resource.Resource {
+	return &InviteResource{}
+}
+
+// InviteResource defines the resource implementation.
+type InviteResource struct {
+	client *HomarrClient
+}
+
+// InviteResourceModel describes the resource data model.
+type InviteResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	ExpirationDate types.String `tfsdk:"expiration_date"`
+	ExpiresIn      types.String `tfsdk:"expires_in"`
+	Token          types.String `tfsdk:"token"`
+	URL            types.String `tfsdk:"url"`
+}
+
+func (r *InviteResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_invite"
+}
+
+func (r *InviteResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an invite link that lets someone register a Homarr account. A used invite is removed from state " +
+			"and an expired one is planned for replacement, so the next apply issues a new one, valid for `expires_in` from then. " +
+			"Requires session_token authentication.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The identifier of the invite. Part of the invite link, so treated as sensitive.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expiration_date": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "When the invite expires, as RFC 3339 timestamp (e.g., `2030-01-31T12:00:00Z`). Changing it issues a new invite. " +
+					"A fixed date can't be renewed once it has passed; use `expires_in` for invites that are re-issued. " +
+					"Exactly one of `expiration_date` or `expires_in` must be set; with `expires_in` this is the computed expiry.",
+				Validators: []validator.String{
+					rfc3339(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"expires_in": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "How long the invite is valid after it is issued, as duration (e.g., `168h` for a week). Changing it issues a new invite.",
+				Validators: []validator.String{
+					positiveDuration(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"token": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The secret token of the invite.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The registration link to send to the invitee.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *InviteResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data InviteResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ExpirationDate.IsUnknown() || data.ExpiresIn.IsUnknown() {
+		return
+	}
+
+	if data.ExpirationDate.IsNull() == data.ExpiresIn.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("expires_in"),
+			"Invalid Invite Expiry",
+			"Exactly one of expiration_date or expires_in must be set.",
+		)
+	}
+}
+
+// ModifyPlan replaces expired invites and warns about new invites that would already be expired
+func (r *InviteResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		r.replaceExpiredInvite(ctx, req, resp)
+		return
+	}
+
+	var expirationDate types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("expiration_date"), &expirationDate)...)
+	if resp.Diagnostics.HasError() || expirationDate.IsUnknown() || expirationDate.IsNull() {
+		return
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339, expirationDate.ValueString())
+	if err != nil {
+		// Reported by the attribute validator
+		return
+	}
+
+	if !expiresAt.After(time.Now()) {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("expiration_date"),
+			"Invite Already Expired",
+			fmt.Sprintf("The expiration date %s is in the past, so no invite is issued. "+
+				"Set a later expiration_date, or use expires_in to issue a new invite whenever the previous one expires.", expirationDate.ValueString()),
+		)
+	}
+}
+
+// replaceExpiredInvite plans the replacement of an issued invite that has expired. Replacing it deletes
+// the expired invite and issues a new one during apply, so refreshing never changes Homarr.
+func (r *InviteResource) replaceExpiredInvite(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var state InviteResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || state.ID.IsNull() {
+		return
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339, state.ExpirationDate.ValueString())
+	if err != nil || expiresAt.After(time.Now()) {
+		return
+	}
+
+	var plan InviteResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.ExpiresIn.IsNull() {
+		plan.ExpirationDate = types.StringUnknown()
+	} else {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("expiration_date"),
+			"Invite Expired",
+			fmt.Sprintf("The invite expired on %s. Applying deletes it without issuing a new one. "+
+				"Set a later expiration_date, or use expires_in to issue a new invite whenever the previous one expires.", state.ExpirationDate.ValueString()),
+		)
+	}
+	plan.ID = types.StringUnknown()
+	plan.Token = types.StringUnknown()
+	plan.URL = types.StringUnknown()
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+	resp.RequiresReplace = append(resp.RequiresReplace, path.Root("expiration_date"))
+}
+
+func (r *InviteResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*HomarrClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *HomarrClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *InviteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data InviteResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.SessionToken == "" {
+		resp.Diagnostics.AddError("Missing Session Token", "Invites require session_token authentication. Please configure session_token in the provider.")
+		return
+	}
+
+	var expiresAt time.Time
+	if !data.ExpiresIn.IsNull() {
+		lifetime, err := time.ParseDuration(data.ExpiresIn.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("expires_in"), "Invalid Expiry Duration", err.Error())
+			return
+		}
+		expiresAt = time.Now().Add(lifetime).UTC().Truncate(time.Second)
+		data.ExpirationDate = types.StringValue(expiresAt.Format(time.RFC3339))
+	} else {
+		var err error
+		expiresAt, err = time.Parse(time.RFC3339, data.ExpirationDate.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("expiration_date"), "Invalid Expiration Date", err.Error())
+			return
+		}
+	}
+
+	// A fixed date in the past can't be issued; record that so plans stay clean until the date is changed
+	if !expiresAt.After(time.Now()) {
+		data.ID = types.StringNull()
+		data.Token = types.StringNull()
+		data.URL = types.StringNull()
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("expiration_date"),
+			"Invite Not Issued",
+			fmt.Sprintf("The expiration date %s is in the past, so no invite was issued. Set a later expiration_date to issue one.", data.ExpirationDate.ValueString()),
+		)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	created, err := r.client.CreateInvite(expiresAt)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create invite: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(created.ID)
+	data.Token = types.StringValue(created.Token)
+	data.URL = types.StringValue(r.client.InviteURL(created.ID, created.Token))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InviteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data InviteResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.SessionToken == "" {
+		resp.Diagnostics.AddError("Missing Session Token", "Invites require session_token authentication. Please configure session_token in the provider.")
+		return
+	}
+
+	// Nothing was issued for an expiration date in the past
+	if data.ID.IsNull() {
+		return
+	}
+
+	// Homarr deletes invites once they are used
+	_, err := r.client.GetInvite(data.ID.ValueString())
+	if IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read invite: %s", err))
+		return
+	}
+
+	// Expired invites stay in state until ModifyPlan replaces them.
+	// The token is only returned on creation and is kept from state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InviteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All configurable attributes require replacement; only computed values can change here
+	var data InviteResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InviteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data InviteResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.SessionToken == "" {
+		resp.Diagnostics.AddError("Missing Session Token", "Invites require session_token authentication. Please configure session_token in the provider.")
+		return
+	}
+
+	if data.ID.IsNull() {
+		return
+	}
+
+	err := r.client.DeleteInvite(data.ID.ValueString())
+	if err != nil && !IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete invite: %s", err))
+		return
+	}
+}
@@ -0,0 +1,326 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &OldmarrImportResource{}
+var _ resource.ResourceWithModifyPlan = &OldmarrImportResource{}
+
+func NewOldmarrImportResource() resource.Resource {
+	return &OldmarrImportResource{}
+}
+
+// OldmarrImportResource defines the resource implementation.
+type OldmarrImportResource struct {
+	client *HomarrClient
+}
+
+// OldmarrImportResourceModel describes the resource data model.
+type OldmarrImportResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	ConfigFile       types.String `tfsdk:"config_file"`
+	ConfigSHA256     types.String `tfsdk:"config_sha256"`
+	Name             types.String `tfsdk:"name"`
+	OnlyImportApps   types.Bool   `tfsdk:"only_import_apps"`
+	ScreenSize       types.String `tfsdk:"screen_size"`
+	SidebarBehaviour types.String `tfsdk:"sidebar_behaviour"`
+	CreatedAppIDs    types.Set    `tfsdk:"created_app_ids"`
+}
+
+func (r *OldmarrImportResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_oldmarr_import"
+}
+
+func (r *OldmarrImportResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Imports a Homarr 0.x (oldmarr) JSON configuration file as a new board, creating the apps it references. " +
+			"Changing any argument or the content of the file re-runs the import. Destroying the resource deletes the imported board " +
+			"but keeps the created apps. " +
+			"Requires session_token authentication.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the imported board.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"config_file": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Path to the oldmarr JSON configuration file.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"config_sha256": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "SHA-256 of the configuration file content. A change re-runs the import.",
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the board to create.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"only_import_apps": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Only import the apps and leave the board without widgets. Defaults to `false`.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"screen_size": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("lg"),
+				MarkdownDescription: "Which oldmarr screen size layout to import: `sm`, `md` or `lg`. Defaults to `lg`.",
+				Validators: []validator.String{
+					stringOneOf("sm", "md", "lg"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"sidebar_behaviour": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("last-section"),
+				MarkdownDescription: "What to do with oldmarr sidebar items: `last-section` moves them to the end of the board, `remove-items` drops them. Defaults to `last-section`.",
+				Validators: []validator.String{
+					stringOneOf("last-section", "remove-items"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"created_app_ids": schema.SetAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The IDs of the apps created by the import.",
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *OldmarrImportResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*HomarrClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *HomarrClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan hashes the configuration file so that edits to it re-run the import
+func (r *OldmarrImportResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var configFile types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("config_file"), &configFile)...)
+	if resp.Diagnostics.HasError() || configFile.IsUnknown() {
+		return
+	}
+
+	content, err := os.ReadFile(configFile.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("config_file"), "Unable to Read Config File", err.Error())
+		return
+	}
+
+	sum := sha256.Sum256(content)
+	hash := hex.EncodeToString(sum[:])
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("config_sha256"), hash)...)
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var priorHash types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("config_sha256"), &priorHash)...)
+	if priorHash.ValueString() != hash {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("config_sha256"))
+	}
+}
+
+func (r *OldmarrImportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data OldmarrImportResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.SessionToken == "" {
+		resp.Diagnostics.AddError("Missing Session Token", "Oldmarr imports require session_token authentication. Please configure session_token in the provider.")
+		return
+	}
+
+	configFile := data.ConfigFile.ValueString()
+	content, err := os.ReadFile(configFile)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("config_file"), "Unable to Read Config File", err.Error())
+		return
+	}
+
+	// Remember existing apps so the ones created by the import can be reported
+	before, err := listAppIDs(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list existing apps: %s", err))
+		return
+	}
+
+	board, err := r.client.ImportOldmarrBoard(filepath.Base(configFile), content, OldmarrImportConfiguration{
+		Name:             data.Name.ValueString(),
+		OnlyImportApps:   data.OnlyImportApps.ValueBool(),
+		ScreenSize:       data.ScreenSize.ValueString(),
+		SidebarBehaviour: data.SidebarBehaviour.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import oldmarr config: %s", err))
+		return
+	}
+
+	sum := sha256.Sum256(content)
+	data.ID = types.StringValue(board.ID)
+	data.ConfigSHA256 = types.StringValue(hex.EncodeToString(sum[:]))
+	data.CreatedAppIDs = types.SetValueMust(types.StringType, []attr.Value{})
+
+	after, err := listAppIDs(r.client)
+	if err != nil {
+		// Save the board anyway so it is deleted with the resource
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list apps after import: %s", err))
+		return
+	}
+
+	createdIDs, diags := types.SetValueFrom(ctx, types.StringType, createdSince(before, after))
+	resp.Diagnostics.Append(diags...)
+	data.CreatedAppIDs = createdIDs
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OldmarrImportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data OldmarrImportResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.SessionToken == "" {
+		resp.Diagnostics.AddError("Missing Session Token", "Oldmarr imports require session_token authentication. Please configure session_token in the provider.")
+		return
+	}
+
+	// If the imported board was deleted, plan a new import
+	_, err := r.client.GetBoard(data.ID.ValueString())
+	if IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read imported board: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OldmarrImportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every argument requires replacement, so there is nothing to update in place
+	var data OldmarrImportResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OldmarrImportResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data OldmarrImportResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.SessionToken == "" {
+		resp.Diagnostics.AddError("Missing Session Token", "Oldmarr imports require session_token authentication. Please configure session_token in the provider.")
+		return
+	}
+
+	// Apps created by the import may already be used elsewhere, so only the board is removed
+	err := r.client.DeleteBoard(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete imported board: %s", err))
+		return
+	}
+}
+
+// listAppIDs takes a snapshot of the app IDs via tRPC.
+// Oldmarr imports don't return the apps they created, so they are found by comparing snapshots.
+func listAppIDs(client *HomarrClient) (map[string]bool, error) {
+	apps, err := client.GetAppsTRPC()
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make(map[string]bool, len(apps))
+	for _, app := range apps {
+		ids[app.ID] = true
+	}
+	return ids, nil
+}
+
+// createdSince returns the IDs in after that aren't in before, sorted
+func createdSince(before, after map[string]bool) []string {
+	created := []string{}
+	for id := range after {
+		if !before[id] {
+			created = append(created, id)
+		}
+	}
+	sort.Strings(created)
+	return created
+}
@@ -0,0 +1,398 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ServerSettingsResource{}
+var _ resource.ResourceWithImportState = &ServerSettingsResource{}
+var _ resource.ResourceWithValidateConfig = &ServerSettingsResource{}
+
+// serverSettingsID is the fixed ID of the singleton server settings resource
+const serverSettingsID = "server_settings"
+
+func NewServerSettingsResource() resource.Resource {
+	return &ServerSettingsResource{}
+}
+
+// ServerSettingsResource defines the resource implementation.
+type ServerSettingsResource struct {
+	client *HomarrClient
+}
+
+// ServerSettingsResourceModel describes the resource data model.
+type ServerSettingsResourceModel struct {
+	ID                  types.String                   `tfsdk:"id"`
+	Analytics           *ServerSettingsAnalyticsModel  `tfsdk:"analytics"`
+	CrawlingAndIndexing *ServerSettingsCrawlingModel   `tfsdk:"crawling_and_indexing"`
+	Board               *ServerSettingsBoardModel      `tfsdk:"board"`
+	Appearance          *ServerSettingsAppearanceModel `tfsdk:"appearance"`
+	Culture             *ServerSettingsCultureModel    `tfsdk:"culture"`
+	Search              *ServerSettingsSearchModel     `tfsdk:"search"`
+}
+
+type ServerSettingsAnalyticsModel struct {
+	EnableGeneral         types.Bool `tfsdk:"enable_general"`
+	EnableWidgetData      types.Bool `tfsdk:"enable_widget_data"`
+	EnableIntegrationData types.Bool `tfsdk:"enable_integration_data"`
+	EnableUserData        types.Bool `tfsdk:"enable_user_data"`
+}
+
+type ServerSettingsCrawlingModel struct {
+	NoIndex              types.Bool `tfsdk:"no_index"`
+	NoFollow             types.Bool `tfsdk:"no_follow"`
+	NoTranslate          types.Bool `tfsdk:"no_translate"`
+	NoSiteLinksSearchBox types.Bool `tfsdk:"no_site_links_search_box"`
+}
+
+type ServerSettingsBoardModel struct {
+	HomeBoardID           types.String `tfsdk:"home_board_id"`
+	MobileHomeBoardID     types.String `tfsdk:"mobile_home_board_id"`
+	EnableStatusByDefault types.Bool   `tfsdk:"enable_status_by_default"`
+	ForceDisableStatus    types.Bool   `tfsdk:"force_disable_status"`
+}
+
+type ServerSettingsAppearanceModel struct {
+	DefaultColorScheme types.String `tfsdk:"default_color_scheme"`
+}
+
+type ServerSettingsCultureModel struct {
+	DefaultLocale types.String `tfsdk:"default_locale"`
+}
+
+type ServerSettingsSearchModel struct {
+	DefaultSearchEngineID types.String `tfsdk:"default_search_engine_id"`
+}
+
+func (r *ServerSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_settings"
+}
+
+// optionalComputedBool returns a bool attribute that keeps the server value when unset
+func optionalComputedBool(description string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: description,
+	}
+}
+
+// optionalComputedString returns a string attribute that keeps the server value when unset
+func optionalComputedString(description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: description,
+	}
+}
+
+func (r *ServerSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the global Homarr server settings. This is a singleton: only attributes that are configured are changed, " +
+			"everything else keeps the value set in the Homarr UI. Destroying the resource leaves the settings untouched. Requires session_token authentication.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Fixed identifier of the server settings (`server_settings`).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"analytics": schema.SingleNestedAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Anonymous analytics settings.",
+				Attributes: map[string]schema.Attribute{
+					"enable_general":          optionalComputedBool("Send general anonymous usage data."),
+					"enable_widget_data":      optionalComputedBool("Send anonymous widget usage data."),
+					"enable_integration_data": optionalComputedBool("Send anonymous integration usage data."),
+					"enable_user_data":        optionalComputedBool("Send anonymous user count data."),
+				},
+			},
+			"crawling_and_indexing": schema.SingleNestedAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Search engine crawling and indexing settings.",
+				Attributes: map[string]schema.Attribute{
+					"no_index":                 optionalComputedBool("Ask search engines not to index Homarr."),
+					"no_follow":                optionalComputedBool("Ask search engines not to follow links."),
+					"no_translate":             optionalComputedBool("Ask search engines not to offer translations."),
+					"no_site_links_search_box": optionalComputedBool("Ask search engines not to show a sitelinks search box."),
+				},
+			},
+			"board": schema.SingleNestedAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Global board settings.",
+				Attributes: map[string]schema.Attribute{
+					"home_board_id":            optionalComputedString("The ID of the board shown as the global home board."),
+					"mobile_home_board_id":     optionalComputedString("The ID of the board shown as the global home board on mobile devices."),
+					"enable_status_by_default": optionalComputedBool("Show app status (ping) on new boards by default."),
+					"force_disable_status":     optionalComputedBool("Disable app status (ping) on all boards."),
+				},
+			},
+			"appearance": schema.SingleNestedAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Appearance settings.",
+				Attributes: map[string]schema.Attribute{
+					"default_color_scheme": optionalComputedString("The default colour scheme: `light` or `dark`."),
+				},
+			},
+			"culture": schema.SingleNestedAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Culture settings.",
+				Attributes: map[string]schema.Attribute{
+					"default_locale": optionalComputedString("The default locale (e.g., `en`, `de`, `en-gb`)."),
+				},
+			},
+			"search": schema.SingleNestedAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Search settings.",
+				Attributes: map[string]schema.Attribute{
+					"default_search_engine_id": optionalComputedString("The ID of the default search engine."),
+				},
+			},
+		},
+	}
+}
+
+func (r *ServerSettingsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var colorScheme types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("appearance").AtName("default_color_scheme"), &colorScheme)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if colorScheme.IsNull() || colorScheme.IsUnknown() {
+		return
+	}
+
+	if scheme := colorScheme.ValueString(); scheme != "light" && scheme != "dark" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("appearance").AtName("default_color_scheme"),
+			"Invalid Color Scheme",
+			fmt.Sprintf("default_color_scheme must be either \"light\" or \"dark\", got: %q", scheme),
+		)
+	}
+}
+
+func (r *ServerSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*HomarrClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *HomarrClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ServerSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var config ServerSettingsResourceModel
+
+	// The config (not the plan) tells us which attributes the user manages
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.SessionToken == "" {
+		resp.Diagnostics.AddError("Missing Session Token", "Server settings require session_token authentication. Please configure session_token in the provider.")
+		return
+	}
+
+	data, err := r.apply(&config)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to save server settings: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (r *ServerSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client.SessionToken == "" {
+		resp.Diagnostics.AddError("Missing Session Token", "Server settings require session_token authentication. Please configure session_token in the provider.")
+		return
+	}
+
+	settings, err := r.client.GetServerSettings()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read server settings: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, serverSettingsToModel(settings))...)
+}
+
+func (r *ServerSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var config ServerSettingsResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.SessionToken == "" {
+		resp.Diagnostics.AddError("Missing Session Token", "Server settings require session_token authentication. Please configure session_token in the provider.")
+		return
+	}
+
+	data, err := r.apply(&config)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to save server settings: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (r *ServerSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Server settings always exist in Homarr, so destroying the resource only
+	// removes it from state and leaves the current settings in place.
+}
+
+func (r *ServerSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), serverSettingsID)...)
+}
+
+// apply merges the configured attributes into the current server settings,
+// saves the sections that were configured and returns the resulting state
+func (r *ServerSettingsResource) apply(config *ServerSettingsResourceModel) (*ServerSettingsResourceModel, error) {
+	settings, err := r.client.GetServerSettings()
+	if err != nil {
+		return nil, err
+	}
+
+	if a := config.Analytics; a != nil {
+		mergeBool(&settings.Analytics.EnableGeneral, a.EnableGeneral)
+		mergeBool(&settings.Analytics.EnableWidgetData, a.EnableWidgetData)
+		mergeBool(&settings.Analytics.EnableIntegrationData, a.EnableIntegrationData)
+		mergeBool(&settings.Analytics.EnableUserData, a.EnableUserData)
+		if err := r.client.SaveServerSettingsSection(ServerSettingsKeyAnalytics, settings.Analytics); err != nil {
+			return nil, err
+		}
+	}
+
+	if c := config.CrawlingAndIndexing; c != nil {
+		mergeBool(&settings.CrawlingAndIndexing.NoIndex, c.NoIndex)
+		mergeBool(&settings.CrawlingAndIndexing.NoFollow, c.NoFollow)
+		mergeBool(&settings.CrawlingAndIndexing.NoTranslate, c.NoTranslate)
+		mergeBool(&settings.CrawlingAndIndexing.NoSiteLinksSearchBox, c.NoSiteLinksSearchBox)
+		if err := r.client.SaveServerSettingsSection(ServerSettingsKeyCrawlingAndIndexing, settings.CrawlingAndIndexing); err != nil {
+			return nil, err
+		}
+	}
+
+	if b := config.Board; b != nil {
+		mergeStringPtr(&settings.Board.HomeBoardID, b.HomeBoardID)
+		mergeStringPtr(&settings.Board.MobileHomeBoardID, b.MobileHomeBoardID)
+		mergeBool(&settings.Board.EnableStatusByDefault, b.EnableStatusByDefault)
+		mergeBool(&settings.Board.ForceDisableStatus, b.ForceDisableStatus)
+		if err := r.client.SaveServerSettingsSection(ServerSettingsKeyBoard, settings.Board); err != nil {
+			return nil, err
+		}
+	}
+
+	if a := config.Appearance; a != nil {
+		if !a.DefaultColorScheme.IsNull() {
+			settings.Appearance.DefaultColorScheme = a.DefaultColorScheme.ValueString()
+		}
+		if err := r.client.SaveServerSettingsSection(ServerSettingsKeyAppearance, settings.Appearance); err != nil {
+			return nil, err
+		}
+	}
+
+	if c := config.Culture; c != nil {
+		if !c.DefaultLocale.IsNull() {
+			settings.Culture.DefaultLocale = c.DefaultLocale.ValueString()
+		}
+		if err := r.client.SaveServerSettingsSection(ServerSettingsKeyCulture, settings.Culture); err != nil {
+			return nil, err
+		}
+	}
+
+	if s := config.Search; s != nil {
+		mergeStringPtr(&settings.Search.DefaultSearchEngineID, s.DefaultSearchEngineID)
+		if err := r.client.SaveServerSettingsSection(ServerSettingsKeySearch, settings.Search); err != nil {
+			return nil, err
+		}
+	}
+
+	// Refresh from API
+	saved, err := r.client.GetServerSettings()
+	if err != nil {
+		return nil, err
+	}
+
+	return serverSettingsToModel(saved), nil
+}
+
+// mergeBool overwrites dst when the attribute is configured
+func mergeBool(dst *bool, v types.Bool) {
+	if !v.IsNull() && !v.IsUnknown() {
+		*dst = v.ValueBool()
+	}
+}
+
+// mergeStringPtr overwrites dst when the attribute is configured
+func mergeStringPtr(dst **string, v types.String) {
+	if !v.IsNull() && !v.IsUnknown() {
+		val := v.ValueString()
+		*dst = &val
+	}
+}
+
+// serverSettingsToModel converts the API settings into the full resource state
+func serverSettingsToModel(settings *ServerSettings) *ServerSettingsResourceModel {
+	return &ServerSettingsResourceModel{
+		ID: types.StringValue(serverSettingsID),
+		Analytics: &ServerSettingsAnalyticsModel{
+			EnableGeneral:         types.BoolValue(settings.Analytics.EnableGeneral),
+			EnableWidgetData:      types.BoolValue(settings.Analytics.EnableWidgetData),
+			EnableIntegrationData: types.BoolValue(settings.Analytics.EnableIntegrationData),
+			EnableUserData:        types.BoolValue(settings.Analytics.EnableUserData),
+		},
+		CrawlingAndIndexing: &ServerSettingsCrawlingModel{
+			NoIndex:              types.BoolValue(settings.CrawlingAndIndexing.NoIndex),
+			NoFollow:             types.BoolValue(settings.CrawlingAndIndexing.NoFollow),
+			NoTranslate:          types.BoolValue(settings.CrawlingAndIndexing.NoTranslate),
+			NoSiteLinksSearchBox: types.BoolValue(settings.CrawlingAndIndexing.NoSiteLinksSearchBox),
+		},
+		Board: &ServerSettingsBoardModel{
+			HomeBoardID:           types.StringPointerValue(settings.Board.HomeBoardID),
+			MobileHomeBoardID:     types.StringPointerValue(settings.Board.MobileHomeBoardID),
+			EnableStatusByDefault: types.BoolValue(settings.Board.EnableStatusByDefault),
+			ForceDisableStatus:    types.BoolValue(settings.Board.ForceDisableStatus),
+		},
+		Appearance: &ServerSettingsAppearanceModel{
+			DefaultColorScheme: types.StringValue(settings.Appearance.DefaultColorScheme),
+		},
+		Culture: &ServerSettingsCultureModel{
+			DefaultLocale: types.StringValue(settings.Culture.DefaultLocale),
+		},
+		Search: &ServerSettingsSearchModel{
+			DefaultSearchEngineID: types.StringPointerValue(settings.Search.DefaultSearchEngineID),
+		},
+	}
+}
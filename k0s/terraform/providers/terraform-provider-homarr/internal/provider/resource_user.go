@@ -0,0 +1,351 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &UserResource{}
+var _ resource.ResourceWithImportState = &UserResource{}
+var _ resource.ResourceWithValidateConfig = &UserResource{}
+var _ resource.ResourceWithModifyPlan = &UserResource{}
+
+// avatarImagePattern matches the data URLs Homarr accepts as profile images
+var avatarImagePattern = regexp.MustCompile(`^data:image/(png|jpeg|gif|webp);base64,[A-Za-z0-9+/]+=*$`)
+
+func NewUserResource() resource.Resource {
+	return &UserResource{}
+}
+
+// UserResource defines the resource implementation.
+type UserResource struct {
+	client *HomarrClient
+}
+
+// UserResourceModel describes the resource data model.
+type UserResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	Username          types.String `tfsdk:"username"`
+	Email             types.String `tfsdk:"email"`
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
+	AvatarImage       types.String `tfsdk:"avatar_image"`
+	Provider          types.String `tfsdk:"provider"`
+}
+
+func (r *UserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (r *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a user in Homarr. New users sign in with credentials; OIDC and LDAP users can be imported " +
+			"but are managed by their identity provider and can't be changed or deleted. Requires session_token authentication.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the user.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"username": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The username used to sign in.",
+			},
+			"email": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The email address of the user.",
+			},
+			"password_wo": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				MarkdownDescription: "The password of the user. Required when creating a user. Write-only: it is never stored in state, so change `password_wo_version` to set a new password. Requires Terraform >= 1.11.",
+			},
+			"password_wo_version": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Change this value to apply a new `password_wo` to an existing user.",
+			},
+			"avatar_image": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The profile image as base64 data URL, e.g. `\"data:image/png;base64,${filebase64(\"avatar.png\")}\"`.",
+				Validators: []validator.String{
+					stringMatches(avatarImagePattern),
+				},
+			},
+			"provider": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "How the user signs in: `credentials`, `oidc` or `ldap`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *UserResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data UserResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.PasswordWOVersion.IsNull() && data.PasswordWO.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("password_wo"),
+			"Missing Password",
+			"password_wo must be set when password_wo_version is set.",
+		)
+	}
+}
+
+// ModifyPlan rejects changes to users that sign in through an identity provider
+func (r *UserResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state UserResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	provider := state.Provider.ValueString()
+	if provider == "" || provider == UserProviderCredentials {
+		return
+	}
+
+	changes := []struct {
+		attribute string
+		changed   bool
+	}{
+		{"username", !plan.Username.Equal(state.Username)},
+		{"email", !plan.Email.Equal(state.Email)},
+		{"password_wo_version", !plan.PasswordWOVersion.Equal(state.PasswordWOVersion)},
+		{"avatar_image", !plan.AvatarImage.Equal(state.AvatarImage)},
+	}
+	for _, change := range changes {
+		if change.changed {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(change.attribute),
+				"User Managed Externally",
+				fmt.Sprintf("User %q signs in with %s, so its profile is managed by the identity provider. "+
+					"Change it there and update the configuration to match.", state.Username.ValueString(), provider),
+			)
+		}
+	}
+}
+
+func (r *UserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*HomarrClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *HomarrClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data UserResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.SessionToken == "" {
+		resp.Diagnostics.AddError("Missing Session Token", "Users require session_token authentication. Please configure session_token in the provider.")
+		return
+	}
+
+	// Write-only values are only available in the configuration
+	var password types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &password)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if password.IsNull() || password.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(path.Root("password_wo"), "Missing Password", "password_wo is required to create a user.")
+		return
+	}
+
+	user, err := r.client.CreateUser(data.Username.ValueString(), data.Email.ValueString(), password.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create user: %s", err))
+		return
+	}
+
+	// Save the ID first so a failed avatar upload does not leave an untracked user
+	data.ID = types.StringValue(user.ID)
+	data.Provider = types.StringValue(user.Provider)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if !data.AvatarImage.IsNull() {
+		if err := r.client.SetUserProfileImage(user.ID, data.AvatarImage.ValueStringPointer()); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set user avatar: %s", err))
+			return
+		}
+	}
+
+	// Refresh from API
+	user, err = r.client.GetUser(user.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user after creation: %s", err))
+		return
+	}
+
+	userToModel(user, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data UserResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.SessionToken == "" {
+		resp.Diagnostics.AddError("Missing Session Token", "Users require session_token authentication. Please configure session_token in the provider.")
+		return
+	}
+
+	user, err := r.client.GetUser(data.ID.ValueString())
+	if IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user: %s", err))
+		return
+	}
+
+	userToModel(user, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state UserResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.SessionToken == "" {
+		resp.Diagnostics.AddError("Missing Session Token", "Users require session_token authentication. Please configure session_token in the provider.")
+		return
+	}
+
+	userID := state.ID.ValueString()
+
+	if !data.Username.Equal(state.Username) || !data.Email.Equal(state.Email) {
+		if err := r.client.EditUserProfile(userID, data.Username.ValueString(), data.Email.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update user profile: %s", err))
+			return
+		}
+	}
+
+	if !data.PasswordWOVersion.Equal(state.PasswordWOVersion) {
+		var password types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &password)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !password.IsNull() && !password.IsUnknown() {
+			if err := r.client.ChangeUserPassword(userID, password.ValueString()); err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to change user password: %s", err))
+				return
+			}
+		}
+	}
+
+	if !data.AvatarImage.Equal(state.AvatarImage) {
+		if err := r.client.SetUserProfileImage(userID, data.AvatarImage.ValueStringPointer()); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set user avatar: %s", err))
+			return
+		}
+	}
+
+	// Refresh from API
+	user, err := r.client.GetUser(userID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user after update: %s", err))
+		return
+	}
+
+	data.ID = state.ID
+	userToModel(user, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data UserResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client.SessionToken == "" {
+		resp.Diagnostics.AddError("Missing Session Token", "Users require session_token authentication. Please configure session_token in the provider.")
+		return
+	}
+
+	if provider := data.Provider.ValueString(); provider != "" && provider != UserProviderCredentials {
+		resp.Diagnostics.AddWarning(
+			"External User Not Deleted",
+			fmt.Sprintf("User %q signs in with %s and is managed by the identity provider. It was removed from the Terraform state only; "+
+				"remove it in the identity provider and Homarr instead.", data.Username.ValueString(), provider),
+		)
+		return
+	}
+
+	err := r.client.DeleteUser(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete user: %s", err))
+		return
+	}
+}
+
+func (r *UserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// userToModel copies the user returned by Homarr into the model, leaving the write-only password untouched
+func userToModel(user *User, data *UserResourceModel) {
+	data.ID = types.StringValue(user.ID)
+	data.Username = types.StringValue(user.Name)
+	data.Email = stringValue(user.Email)
+	data.AvatarImage = types.StringPointerValue(user.Image)
+	data.Provider = types.StringValue(user.Provider)
+}
@@ -0,0 +1,461 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	_ "time/tzdata" // time zone validation must not depend on the zoneinfo of the host
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// stringOneOfValidator validates that a string is one of a fixed set of values
+type stringOneOfValidator struct {
+	values []string
+}
+
+// stringOneOf returns a validator which ensures a string is one of the given values
+func stringOneOf(values ...string) validator.String {
+	return stringOneOfValidator{values: values}
+}
+
+func (v stringOneOfValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be one of: %s", strings.Join(v.values, ", "))
+}
+
+func (v stringOneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v stringOneOfValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	for _, allowed := range v.values {
+		if value == allowed {
+			return
+		}
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid Attribute Value",
+		fmt.Sprintf("Attribute %s %s, got: %q", req.Path, v.Description(ctx), value),
+	)
+}
+
+// mapValuesOneOfValidator validates that every value of a string map is one of a fixed set of values
+type mapValuesOneOfValidator struct {
+	values []string
+}
+
+// mapValuesOneOf returns a validator which ensures every map value is one of the given values
+func mapValuesOneOf(values ...string) validator.Map {
+	return mapValuesOneOfValidator{values: values}
+}
+
+func (v mapValuesOneOfValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("map values must be one of: %s", strings.Join(v.values, ", "))
+}
+
+func (v mapValuesOneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v mapValuesOneOfValidator) ValidateMap(ctx context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for key, element := range req.ConfigValue.Elements() {
+		value, ok := element.(types.String)
+		if !ok || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		elementReq := validator.StringRequest{
+			Path:        req.Path.AtMapKey(key),
+			ConfigValue: value,
+		}
+		elementResp := &validator.StringResponse{}
+		stringOneOfValidator(v).ValidateString(ctx, elementReq, elementResp)
+		resp.Diagnostics.Append(elementResp.Diagnostics...)
+	}
+}
+
+// mapKeysOneOfValidator validates that every key of a map is one of a fixed set of values
+type mapKeysOneOfValidator struct {
+	values []string
+}
+
+// mapKeysOneOf returns a validator which ensures every map key is one of the given values
+func mapKeysOneOf(values ...string) validator.Map {
+	return mapKeysOneOfValidator{values: values}
+}
+
+func (v mapKeysOneOfValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("map keys must be one of: %s", strings.Join(v.values, ", "))
+}
+
+func (v mapKeysOneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v mapKeysOneOfValidator) ValidateMap(ctx context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for key := range req.ConfigValue.Elements() {
+		valid := false
+		for _, allowed := range v.values {
+			if key == allowed {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtMapKey(key),
+				"Invalid Attribute Value",
+				fmt.Sprintf("Attribute %s %s, got: %q", req.Path, v.Description(ctx), key),
+			)
+		}
+	}
+}
+
+// stringMatchesValidator validates that a string matches a regular expression
+type stringMatchesValidator struct {
+	pattern *regexp.Regexp
+}
+
+// stringMatches returns a validator which ensures a string matches the given regular expression
+func stringMatches(pattern *regexp.Regexp) validator.String {
+	return stringMatchesValidator{pattern: pattern}
+}
+
+func (v stringMatchesValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must match the regular expression %s", v.pattern)
+}
+
+func (v stringMatchesValidator) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("value must match the regular expression `%s`", v.pattern)
+}
+
+func (v stringMatchesValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if !v.pattern.MatchString(value) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %q", req.Path, v.Description(ctx), value),
+		)
+	}
+}
+
+// setValuesOneOfValidator validates that every element of a string set is one of a fixed set of values
+type setValuesOneOfValidator struct {
+	values []string
+}
+
+// setValuesOneOf returns a validator which ensures every set element is one of the given values
+func setValuesOneOf(values ...string) validator.Set {
+	return setValuesOneOfValidator{values: values}
+}
+
+func (v setValuesOneOfValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("set elements must be one of: %s", strings.Join(v.values, ", "))
+}
+
+func (v setValuesOneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v setValuesOneOfValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for _, element := range req.ConfigValue.Elements() {
+		value, ok := element.(types.String)
+		if !ok || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		elementReq := validator.StringRequest{
+			Path:        req.Path.AtSetValue(value),
+			ConfigValue: value,
+		}
+		elementResp := &validator.StringResponse{}
+		stringOneOfValidator(v).ValidateString(ctx, elementReq, elementResp)
+		resp.Diagnostics.Append(elementResp.Diagnostics...)
+	}
+}
+
+// listValuesOneOfValidator validates that every element of a string list is one of a fixed set of values
+type listValuesOneOfValidator struct {
+	values []string
+}
+
+// listValuesOneOf returns a validator which ensures every list element is one of the given values
+func listValuesOneOf(values ...string) validator.List {
+	return listValuesOneOfValidator{values: values}
+}
+
+func (v listValuesOneOfValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("list elements must be one of: %s", strings.Join(v.values, ", "))
+}
+
+func (v listValuesOneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v listValuesOneOfValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for i, element := range req.ConfigValue.Elements() {
+		value, ok := element.(types.String)
+		if !ok || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		elementReq := validator.StringRequest{
+			Path:        req.Path.AtListIndex(i),
+			ConfigValue: value,
+		}
+		elementResp := &validator.StringResponse{}
+		stringOneOfValidator(v).ValidateString(ctx, elementReq, elementResp)
+		resp.Diagnostics.Append(elementResp.Diagnostics...)
+	}
+}
+
+// listValuesMatchValidator validates that every element of a string list matches a regular expression
+type listValuesMatchValidator struct {
+	pattern *regexp.Regexp
+}
+
+// listValuesMatch returns a validator which ensures every list element matches the given regular expression
+func listValuesMatch(pattern *regexp.Regexp) validator.List {
+	return listValuesMatchValidator{pattern: pattern}
+}
+
+func (v listValuesMatchValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("list elements must match the regular expression %s", v.pattern)
+}
+
+func (v listValuesMatchValidator) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("list elements must match the regular expression `%s`", v.pattern)
+}
+
+func (v listValuesMatchValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for i, element := range req.ConfigValue.Elements() {
+		value, ok := element.(types.String)
+		if !ok || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		elementReq := validator.StringRequest{
+			Path:        req.Path.AtListIndex(i),
+			ConfigValue: value,
+		}
+		elementResp := &validator.StringResponse{}
+		stringMatchesValidator(v).ValidateString(ctx, elementReq, elementResp)
+		resp.Diagnostics.Append(elementResp.Diagnostics...)
+	}
+}
+
+// timeZoneValidator validates that a string is an IANA time zone name
+type timeZoneValidator struct{}
+
+// timeZone returns a validator which ensures a string is an IANA time zone name such as Europe/Berlin
+func timeZone() validator.String {
+	return timeZoneValidator{}
+}
+
+func (v timeZoneValidator) Description(ctx context.Context) string {
+	return "value must be an IANA time zone name such as Europe/Berlin"
+}
+
+func (v timeZoneValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be an IANA time zone name such as `Europe/Berlin`"
+}
+
+func (v timeZoneValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	// time.LoadLocation maps "" and "Local" to the host's zone, which Homarr doesn't know
+	if _, err := time.LoadLocation(value); err != nil || value == "" || value == "Local" {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %q", req.Path, v.Description(ctx), value),
+		)
+	}
+}
+
+// rfc3339Validator validates that a string is an RFC 3339 timestamp
+type rfc3339Validator struct{}
+
+// rfc3339 returns a validator which ensures a string is an RFC 3339 timestamp
+func rfc3339() validator.String {
+	return rfc3339Validator{}
+}
+
+func (v rfc3339Validator) Description(ctx context.Context) string {
+	return "value must be an RFC 3339 timestamp such as 2030-01-31T12:00:00Z"
+}
+
+func (v rfc3339Validator) MarkdownDescription(ctx context.Context) string {
+	return "value must be an RFC 3339 timestamp such as `2030-01-31T12:00:00Z`"
+}
+
+func (v rfc3339Validator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if _, err := time.Parse(time.RFC3339, value); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %q", req.Path, v.Description(ctx), value),
+		)
+	}
+}
+
+// positiveDurationValidator validates that a string is a positive Go duration
+type positiveDurationValidator struct{}
+
+// positiveDuration returns a validator which ensures a string is a positive duration such as 168h
+func positiveDuration() validator.String {
+	return positiveDurationValidator{}
+}
+
+func (v positiveDurationValidator) Description(ctx context.Context) string {
+	return "value must be a positive duration such as 168h or 30m"
+}
+
+func (v positiveDurationValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be a positive duration such as `168h` or `30m`"
+}
+
+func (v positiveDurationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if d, err := time.ParseDuration(value); err != nil || d <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %q", req.Path, v.Description(ctx), value),
+		)
+	}
+}
+
+// int64BetweenValidator validates that an integer lies within an inclusive range
+type int64BetweenValidator struct {
+	min, max int64
+}
+
+// int64Between returns a validator which ensures an integer is between min and max (inclusive)
+func int64Between(min, max int64) validator.Int64 {
+	return int64BetweenValidator{min: min, max: max}
+}
+
+func (v int64BetweenValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be between %d and %d", v.min, v.max)
+}
+
+func (v int64BetweenValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v int64BetweenValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueInt64()
+	if value < v.min || value > v.max {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %d", req.Path, v.Description(ctx), value),
+		)
+	}
+}
+
+// float64BetweenValidator validates that a number lies within an inclusive range
+type float64BetweenValidator struct {
+	min, max float64
+}
+
+// float64Between returns a validator which ensures a number is between min and max (inclusive)
+func float64Between(min, max float64) validator.Float64 {
+	return float64BetweenValidator{min: min, max: max}
+}
+
+func (v float64BetweenValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be between %g and %g", v.min, v.max)
+}
+
+func (v float64BetweenValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v float64BetweenValidator) ValidateFloat64(ctx context.Context, req validator.Float64Request, resp *validator.Float64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueFloat64()
+	if value < v.min || value > v.max {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %g", req.Path, v.Description(ctx), value),
+		)
+	}
+}
+
+// validateIDOrName checks that exactly one of the id and name lookup attributes is set
+func validateIDOrName(id, name types.String, idPath path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if id.IsUnknown() || name.IsUnknown() {
+		return diags
+	}
+
+	if id.IsNull() == name.IsNull() {
+		diags.AddAttributeError(
+			idPath,
+			"Invalid Lookup",
+			fmt.Sprintf("Exactly one of %s or name must be set.", idPath),
+		)
+	}
+
+	return diags
+}
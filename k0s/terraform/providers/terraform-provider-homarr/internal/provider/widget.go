@@ -0,0 +1,866 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &WidgetResource{}
+var _ resource.ResourceWithImportState = &WidgetResource{}
+var _ resource.ResourceWithModifyPlan = &WidgetResource{}
+
+// widgetOptionType is the Terraform type of a widget option
+type widgetOptionType int
+
+const (
+	widgetOptionBool widgetOptionType = iota
+	widgetOptionString
+	widgetOptionInt
+	widgetOptionNumber
+	widgetOptionStringList
+)
+
+// widgetOption describes a single option of a widget. Name is the Terraform
+// attribute name, Key the option key Homarr stores in the item options; keys
+// of options nested in an object are dot separated (e.g. location.latitude).
+type widgetOption struct {
+	Name        string
+	Key         string
+	Type        widgetOptionType
+	Description string
+	// Default is a bool, string, int64, float64 or []string matching Type
+	Default interface{}
+	// OneOf restricts string options and the elements of string list options
+	OneOf []string
+	// Min and Max restrict int and number options when Max > Min
+	Min, Max float64
+	// Required string options have no default and must be configured
+	Required bool
+	// Pattern restricts string options and the elements of string list options
+	// to values matching a regular expression
+	Pattern *regexp.Regexp
+	// StringValidators are additional validators of string options
+	StringValidators []validator.String
+}
+
+// widgetDefinition describes a Homarr widget kind exposed as a homarr_widget_<name> resource
+type widgetDefinition struct {
+	Name             string
+	Kind             string
+	Description      string
+	IntegrationKinds []string
+	Width            int64
+	Height           int64
+	Options          []widgetOption
+}
+
+// widgetDefinitions returns the definitions of all supported widgets
+func widgetDefinitions() []widgetDefinition {
+	var definitions []widgetDefinition
+	definitions = append(definitions, mediaWidgetDefinitions...)
+	definitions = append(definitions, networkWidgetDefinitions...)
+	definitions = append(definitions, smartHomeWidgetDefinitions...)
+	definitions = append(definitions, systemWidgetDefinitions...)
+	definitions = append(definitions, generalWidgetDefinitions...)
+	return definitions
+}
+
+// widgetResources returns a resource constructor for every supported widget
+func widgetResources() []func() resource.Resource {
+	var resources []func() resource.Resource
+	for _, definition := range widgetDefinitions() {
+		definition := definition
+		resources = append(resources, func() resource.Resource {
+			return &WidgetResource{definition: definition}
+		})
+	}
+	return resources
+}
+
+// WidgetResource defines the resource implementation shared by all widget resources.
+type WidgetResource struct {
+	client     *HomarrClient
+	definition widgetDefinition
+}
+
+// widgetPlacement holds the attributes every widget resource has in common
+type widgetPlacement struct {
+	ID             types.String
+	BoardID        types.String
+	SectionID      types.String
+	XOffset        types.Int64
+	YOffset        types.Int64
+	Width          types.Int64
+	Height         types.Int64
+	Title          types.String
+	IntegrationIDs types.Set
+}
+
+// attributeGetter is implemented by tfsdk.Plan, tfsdk.State and tfsdk.Config
+type attributeGetter interface {
+	GetAttribute(ctx context.Context, p path.Path, target interface{}) diag.Diagnostics
+}
+
+func (r *WidgetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_widget_" + r.definition.Name
+}
+
+func (r *WidgetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The unique identifier of the widget item.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"board_id": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "The ID of the board the widget is placed on.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"section_id": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "The ID of the board section the widget is placed in. Defaults to the topmost empty section of the board.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"x_offset": schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(0),
+			MarkdownDescription: "The column the widget starts in. Defaults to `0`.",
+			Validators:          []validator.Int64{int64Between(0, 96)},
+		},
+		"y_offset": schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(0),
+			MarkdownDescription: "The row the widget starts in. Defaults to `0`.",
+			Validators:          []validator.Int64{int64Between(0, 1000)},
+		},
+		"width": schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(r.definition.Width),
+			MarkdownDescription: fmt.Sprintf("The width of the widget in columns. Defaults to `%d`.", r.definition.Width),
+			Validators:          []validator.Int64{int64Between(1, 96)},
+		},
+		"height": schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(r.definition.Height),
+			MarkdownDescription: fmt.Sprintf("The height of the widget in rows. Defaults to `%d`.", r.definition.Height),
+			Validators:          []validator.Int64{int64Between(1, 1000)},
+		},
+		"title": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "A custom title shown above the widget.",
+		},
+	}
+
+	if len(r.definition.IntegrationKinds) > 0 {
+		attributes["integration_ids"] = schema.SetAttribute{
+			Optional:            true,
+			Computed:            true,
+			ElementType:         types.StringType,
+			Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			MarkdownDescription: fmt.Sprintf("The IDs of the integrations the widget displays. Supported kinds: %s.", markdownList(r.definition.IntegrationKinds)),
+		}
+	}
+
+	for _, option := range r.definition.Options {
+		attributes[option.Name] = option.schemaAttribute()
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: r.definition.Description + " The position is applied to every layout of the board. Requires session_token authentication.",
+		Attributes:          attributes,
+	}
+}
+
+func (r *WidgetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*HomarrClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *HomarrClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan rejects integrations whose kind the widget can't display, so a
+// mistake surfaces in the plan instead of as an empty widget on the board.
+// IDs that are only known after apply are checked again in Create and Update.
+func (r *WidgetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || len(r.definition.IntegrationKinds) == 0 {
+		return
+	}
+
+	// Without credentials the check is left to apply time
+	if r.client == nil || r.client.SessionToken == "" {
+		return
+	}
+
+	var integrationIDs types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("integration_ids"), &integrationIDs)...)
+	if resp.Diagnostics.HasError() || integrationIDs.IsUnknown() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.checkIntegrationKinds(integrationIDs)...)
+}
+
+// checkIntegrationKinds reports integrations that don't exist or whose kind the widget can't display.
+// Unknown IDs are skipped.
+func (r *WidgetResource) checkIntegrationKinds(integrationIDs types.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var ids []string
+	for _, element := range integrationIDs.Elements() {
+		if id, ok := element.(types.String); ok && !id.IsNull() && !id.IsUnknown() {
+			ids = append(ids, id.ValueString())
+		}
+	}
+	if len(ids) == 0 {
+		return diags
+	}
+
+	integrations, err := r.client.GetIntegrations()
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read integrations: %s", err))
+		return diags
+	}
+
+	byID := make(map[string]Integration, len(integrations))
+	for _, integration := range integrations {
+		byID[integration.ID] = integration
+	}
+
+	for _, id := range ids {
+		integration, ok := byID[id]
+		if !ok {
+			diags.AddAttributeError(
+				path.Root("integration_ids"),
+				"Integration Not Found",
+				fmt.Sprintf("No integration found with ID %q.", id),
+			)
+			continue
+		}
+
+		if !r.supportsIntegrationKind(integration.Kind) {
+			diags.AddAttributeError(
+				path.Root("integration_ids"),
+				"Unsupported Integration Kind",
+				fmt.Sprintf("Integration %q (%s) has kind %s, but the %s widget only supports: %s.",
+					integration.Name, integration.ID, integration.Kind, r.definition.Kind, strings.Join(r.definition.IntegrationKinds, ", ")),
+			)
+		}
+	}
+
+	return diags
+}
+
+// supportsIntegrationKind reports whether the widget can display integrations of the given kind
+func (r *WidgetResource) supportsIntegrationKind(kind string) bool {
+	for _, supported := range r.definition.IntegrationKinds {
+		if supported == kind {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *WidgetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client.SessionToken == "" {
+		resp.Diagnostics.AddError("Missing Session Token", "Widgets require session_token authentication. Please configure session_token in the provider.")
+		return
+	}
+
+	placement, diags := r.getPlacement(ctx, &req.Plan)
+	resp.Diagnostics.Append(diags...)
+	options, diags := r.getOptions(ctx, &req.Plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Integrations created in the same apply weren't known when the plan was checked
+	resp.Diagnostics.Append(r.checkIntegrationKinds(placement.IntegrationIDs)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	itemID, err := newID()
+	if err != nil {
+		resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("Unable to create widget: %s", err))
+		return
+	}
+
+	var sectionID string
+	err = r.client.ModifyBoardContent(placement.BoardID.ValueString(), func(board *BoardDetail) error {
+		var err error
+		sectionID, err = widgetSectionID(board, placement.SectionID)
+		if err != nil {
+			return err
+		}
+
+		item := BoardItem{
+			ID:      itemID,
+			Kind:    r.definition.Kind,
+			Options: options,
+			AdvancedOptions: BoardItemAdvancedOptions{
+				CustomCSSClasses: []string{},
+			},
+		}
+		r.applyPlacement(board, &item, placement, sectionID)
+		board.Items = append(board.Items, item)
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create widget: %s", err))
+		return
+	}
+
+	resp.State.Raw = req.Plan.Raw
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), itemID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("section_id"), sectionID)...)
+}
+
+func (r *WidgetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client.SessionToken == "" {
+		resp.Diagnostics.AddError("Missing Session Token", "Widgets require session_token authentication. Please configure session_token in the provider.")
+		return
+	}
+
+	placement, diags := r.getPlacement(ctx, &req.State)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	board, err := r.client.GetBoardDetail(placement.BoardID.ValueString())
+	if IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read board: %s", err))
+		return
+	}
+
+	item := findBoardItem(board, placement.ID.ValueString())
+	if item == nil || item.Kind != r.definition.Kind {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(r.setFromItem(ctx, &resp.State, board, item)...)
+}
+
+func (r *WidgetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client.SessionToken == "" {
+		resp.Diagnostics.AddError("Missing Session Token", "Widgets require session_token authentication. Please configure session_token in the provider.")
+		return
+	}
+
+	placement, diags := r.getPlacement(ctx, &req.Plan)
+	resp.Diagnostics.Append(diags...)
+	options, diags := r.getOptions(ctx, &req.Plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Integrations created in the same apply weren't known when the plan was checked
+	resp.Diagnostics.Append(r.checkIntegrationKinds(placement.IntegrationIDs)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var sectionID string
+	err := r.client.ModifyBoardContent(placement.BoardID.ValueString(), func(board *BoardDetail) error {
+		item := findBoardItem(board, placement.ID.ValueString())
+		if item == nil {
+			return &NotFoundError{Kind: "widget", ID: placement.ID.ValueString()}
+		}
+
+		var err error
+		sectionID, err = widgetSectionID(board, placement.SectionID)
+		if err != nil {
+			return err
+		}
+
+		// Keep options this provider doesn't manage
+		if item.Options == nil {
+			item.Options = map[string]interface{}{}
+		}
+		mergeWidgetOptions(item.Options, options)
+		r.applyPlacement(board, item, placement, sectionID)
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update widget: %s", err))
+		return
+	}
+
+	resp.State.Raw = req.Plan.Raw
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("section_id"), sectionID)...)
+}
+
+func (r *WidgetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client.SessionToken == "" {
+		resp.Diagnostics.AddError("Missing Session Token", "Widgets require session_token authentication. Please configure session_token in the provider.")
+		return
+	}
+
+	placement, diags := r.getPlacement(ctx, &req.State)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.ModifyBoardContent(placement.BoardID.ValueString(), func(board *BoardDetail) error {
+		items := make([]BoardItem, 0, len(board.Items))
+		for _, item := range board.Items {
+			if item.ID != placement.ID.ValueString() {
+				items = append(items, item)
+			}
+		}
+		board.Items = items
+		return nil
+	})
+	if IsNotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete widget: %s", err))
+		return
+	}
+}
+
+func (r *WidgetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	boardID, itemID, ok := strings.Cut(req.ID, "/")
+	if !ok || boardID == "" || itemID == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected an import ID of the form <board_id>/<item_id>, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("board_id"), boardID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), itemID)...)
+}
+
+// getPlacement reads the common widget attributes from a plan or state
+func (r *WidgetResource) getPlacement(ctx context.Context, source attributeGetter) (widgetPlacement, diag.Diagnostics) {
+	var placement widgetPlacement
+	var diags diag.Diagnostics
+
+	diags.Append(source.GetAttribute(ctx, path.Root("id"), &placement.ID)...)
+	diags.Append(source.GetAttribute(ctx, path.Root("board_id"), &placement.BoardID)...)
+	diags.Append(source.GetAttribute(ctx, path.Root("section_id"), &placement.SectionID)...)
+	diags.Append(source.GetAttribute(ctx, path.Root("x_offset"), &placement.XOffset)...)
+	diags.Append(source.GetAttribute(ctx, path.Root("y_offset"), &placement.YOffset)...)
+	diags.Append(source.GetAttribute(ctx, path.Root("width"), &placement.Width)...)
+	diags.Append(source.GetAttribute(ctx, path.Root("height"), &placement.Height)...)
+	diags.Append(source.GetAttribute(ctx, path.Root("title"), &placement.Title)...)
+
+	placement.IntegrationIDs = types.SetNull(types.StringType)
+	if len(r.definition.IntegrationKinds) > 0 {
+		diags.Append(source.GetAttribute(ctx, path.Root("integration_ids"), &placement.IntegrationIDs)...)
+	}
+
+	return placement, diags
+}
+
+// getOptions reads the widget options from a plan and converts them to Homarr option values
+func (r *WidgetResource) getOptions(ctx context.Context, source attributeGetter) (map[string]interface{}, diag.Diagnostics) {
+	options := make(map[string]interface{}, len(r.definition.Options))
+	var diags diag.Diagnostics
+
+	for _, option := range r.definition.Options {
+		value, d := option.read(ctx, source)
+		diags.Append(d...)
+		if value != nil {
+			setWidgetOption(options, option.Key, value)
+		}
+	}
+
+	return options, diags
+}
+
+// applyPlacement sets the title, integrations and layouts of an item from the planned placement
+func (r *WidgetResource) applyPlacement(board *BoardDetail, item *BoardItem, placement widgetPlacement, sectionID string) {
+	item.AdvancedOptions.Title = placement.Title.ValueStringPointer()
+
+	item.IntegrationIDs = []string{}
+	for _, element := range placement.IntegrationIDs.Elements() {
+		if id, ok := element.(types.String); ok {
+			item.IntegrationIDs = append(item.IntegrationIDs, id.ValueString())
+		}
+	}
+	sort.Strings(item.IntegrationIDs)
+
+	item.Layouts = make([]BoardItemLayout, 0, len(board.Layouts))
+	for _, layout := range board.Layouts {
+		width := int(placement.Width.ValueInt64())
+		xOffset := int(placement.XOffset.ValueInt64())
+		// Narrower layouts can't fit the widget at the configured position
+		if layout.ColumnCount > 0 {
+			width = min(width, layout.ColumnCount)
+			xOffset = min(xOffset, layout.ColumnCount-width)
+		}
+
+		item.Layouts = append(item.Layouts, BoardItemLayout{
+			LayoutID:  layout.ID,
+			SectionID: sectionID,
+			XOffset:   xOffset,
+			YOffset:   int(placement.YOffset.ValueInt64()),
+			Width:     width,
+			Height:    int(placement.Height.ValueInt64()),
+		})
+	}
+}
+
+// setFromItem writes the current item into state, using the widest layout as the source of the position
+func (r *WidgetResource) setFromItem(ctx context.Context, state interface {
+	SetAttribute(ctx context.Context, p path.Path, val interface{}) diag.Diagnostics
+}, board *BoardDetail, item *BoardItem) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if layout := primaryItemLayout(board, item); layout != nil {
+		diags.Append(state.SetAttribute(ctx, path.Root("section_id"), layout.SectionID)...)
+		diags.Append(state.SetAttribute(ctx, path.Root("x_offset"), int64(layout.XOffset))...)
+		diags.Append(state.SetAttribute(ctx, path.Root("y_offset"), int64(layout.YOffset))...)
+		diags.Append(state.SetAttribute(ctx, path.Root("width"), int64(layout.Width))...)
+		diags.Append(state.SetAttribute(ctx, path.Root("height"), int64(layout.Height))...)
+	}
+
+	diags.Append(state.SetAttribute(ctx, path.Root("title"), types.StringPointerValue(item.AdvancedOptions.Title))...)
+
+	if len(r.definition.IntegrationKinds) > 0 {
+		integrationIDs, d := types.SetValueFrom(ctx, types.StringType, append([]string{}, item.IntegrationIDs...))
+		diags.Append(d...)
+		diags.Append(state.SetAttribute(ctx, path.Root("integration_ids"), integrationIDs)...)
+	}
+
+	for _, option := range r.definition.Options {
+		raw, ok := widgetOptionValue(item.Options, option.Key)
+		if !ok {
+			continue
+		}
+		value, ok := option.value(raw)
+		if !ok {
+			continue
+		}
+		diags.Append(state.SetAttribute(ctx, path.Root(option.Name), value)...)
+	}
+
+	return diags
+}
+
+// schemaAttribute builds the Terraform attribute of a widget option
+func (o widgetOption) schemaAttribute() schema.Attribute {
+	description := o.Description
+
+	switch o.Type {
+	case widgetOptionBool:
+		return schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(o.Default.(bool)),
+			MarkdownDescription: fmt.Sprintf("%s Defaults to `%t`.", description, o.Default.(bool)),
+		}
+	case widgetOptionString:
+		var validators []validator.String
+		if len(o.OneOf) > 0 {
+			validators = append(validators, stringOneOf(o.OneOf...))
+			description += " One of " + markdownList(o.OneOf) + "."
+		}
+		if o.Pattern != nil {
+			validators = append(validators, stringMatches(o.Pattern))
+		}
+		validators = append(validators, o.StringValidators...)
+		if o.Required {
+			return schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: description,
+				Validators:          validators,
+			}
+		}
+		if def := o.Default.(string); def != "" {
+			description += fmt.Sprintf(" Defaults to `%s`.", def)
+		}
+		return schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(o.Default.(string)),
+			MarkdownDescription: description,
+			Validators:          validators,
+		}
+	case widgetOptionInt:
+		var validators []validator.Int64
+		if o.Max > o.Min {
+			validators = append(validators, int64Between(int64(o.Min), int64(o.Max)))
+		}
+		return schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(o.Default.(int64)),
+			MarkdownDescription: fmt.Sprintf("%s Defaults to `%d`.", description, o.Default.(int64)),
+			Validators:          validators,
+		}
+	case widgetOptionNumber:
+		var validators []validator.Float64
+		if o.Max > o.Min {
+			validators = append(validators, float64Between(o.Min, o.Max))
+		}
+		return schema.Float64Attribute{
+			Optional:            true,
+			Computed:            true,
+			Default:             float64default.StaticFloat64(o.Default.(float64)),
+			MarkdownDescription: fmt.Sprintf("%s Defaults to `%g`.", description, o.Default.(float64)),
+			Validators:          validators,
+		}
+	default:
+		var validators []validator.List
+		if len(o.OneOf) > 0 {
+			validators = append(validators, listValuesOneOf(o.OneOf...))
+			description += " Elements must be one of " + markdownList(o.OneOf) + "."
+		}
+		if o.Pattern != nil {
+			validators = append(validators, listValuesMatch(o.Pattern))
+		}
+		elements := []attr.Value{}
+		for _, value := range o.Default.([]string) {
+			elements = append(elements, types.StringValue(value))
+		}
+		if len(elements) > 0 {
+			description += " Defaults to " + markdownList(o.Default.([]string)) + "."
+		}
+		return schema.ListAttribute{
+			Optional:            true,
+			Computed:            true,
+			ElementType:         types.StringType,
+			Default:             listdefault.StaticValue(types.ListValueMust(types.StringType, elements)),
+			MarkdownDescription: description,
+			Validators:          validators,
+		}
+	}
+}
+
+// read returns the option value from a plan as a JSON-compatible value, or nil when it is not known
+func (o widgetOption) read(ctx context.Context, source attributeGetter) (interface{}, diag.Diagnostics) {
+	switch o.Type {
+	case widgetOptionBool:
+		var value types.Bool
+		diags := source.GetAttribute(ctx, path.Root(o.Name), &value)
+		if value.IsNull() || value.IsUnknown() {
+			return nil, diags
+		}
+		return value.ValueBool(), diags
+	case widgetOptionString:
+		var value types.String
+		diags := source.GetAttribute(ctx, path.Root(o.Name), &value)
+		if value.IsNull() || value.IsUnknown() {
+			return nil, diags
+		}
+		return value.ValueString(), diags
+	case widgetOptionInt:
+		var value types.Int64
+		diags := source.GetAttribute(ctx, path.Root(o.Name), &value)
+		if value.IsNull() || value.IsUnknown() {
+			return nil, diags
+		}
+		return value.ValueInt64(), diags
+	case widgetOptionNumber:
+		var value types.Float64
+		diags := source.GetAttribute(ctx, path.Root(o.Name), &value)
+		if value.IsNull() || value.IsUnknown() {
+			return nil, diags
+		}
+		return value.ValueFloat64(), diags
+	default:
+		var value types.List
+		diags := source.GetAttribute(ctx, path.Root(o.Name), &value)
+		if value.IsNull() || value.IsUnknown() {
+			return nil, diags
+		}
+		values := []string{}
+		diags.Append(value.ElementsAs(ctx, &values, false)...)
+		return values, diags
+	}
+}
+
+// value converts an option value returned by Homarr to its Terraform value
+func (o widgetOption) value(raw interface{}) (attr.Value, bool) {
+	switch o.Type {
+	case widgetOptionBool:
+		v, ok := raw.(bool)
+		return types.BoolValue(v), ok
+	case widgetOptionString:
+		v, ok := raw.(string)
+		return types.StringValue(v), ok
+	case widgetOptionInt:
+		v, ok := raw.(float64)
+		return types.Int64Value(int64(v)), ok
+	case widgetOptionNumber:
+		v, ok := raw.(float64)
+		return types.Float64Value(v), ok
+	default:
+		list, ok := raw.([]interface{})
+		if !ok {
+			return nil, false
+		}
+		elements := make([]attr.Value, 0, len(list))
+		for _, element := range list {
+			s, ok := element.(string)
+			if !ok {
+				return nil, false
+			}
+			elements = append(elements, types.StringValue(s))
+		}
+		return types.ListValueMust(types.StringType, elements), true
+	}
+}
+
+// setWidgetOption stores a value under a dot separated option key, creating nested objects as needed
+func setWidgetOption(options map[string]interface{}, key string, value interface{}) {
+	parent, child, nested := strings.Cut(key, ".")
+	if !nested {
+		options[key] = value
+		return
+	}
+
+	inner, ok := options[parent].(map[string]interface{})
+	if !ok {
+		inner = map[string]interface{}{}
+		options[parent] = inner
+	}
+	setWidgetOption(inner, child, value)
+}
+
+// widgetOptionValue returns the value stored under a dot separated option key
+func widgetOptionValue(options map[string]interface{}, key string) (interface{}, bool) {
+	parent, child, nested := strings.Cut(key, ".")
+	if !nested {
+		value, ok := options[key]
+		return value, ok
+	}
+
+	inner, ok := options[parent].(map[string]interface{})
+	if !ok {
+		return nil, false
+	}
+	return widgetOptionValue(inner, child)
+}
+
+// mergeWidgetOptions copies src into dst, keeping nested keys of dst that src doesn't set
+func mergeWidgetOptions(dst, src map[string]interface{}) {
+	for key, value := range src {
+		if nested, ok := value.(map[string]interface{}); ok {
+			if existing, ok := dst[key].(map[string]interface{}); ok {
+				mergeWidgetOptions(existing, nested)
+				continue
+			}
+		}
+		dst[key] = value
+	}
+}
+
+// widgetSectionID returns the configured section after checking it exists, or the topmost empty section of the board
+func widgetSectionID(board *BoardDetail, configured types.String) (string, error) {
+	if !configured.IsNull() && !configured.IsUnknown() {
+		for _, section := range board.Sections {
+			if section.ID == configured.ValueString() {
+				return section.ID, nil
+			}
+		}
+		return "", fmt.Errorf("section %s not found on board %s", configured.ValueString(), board.Name)
+	}
+
+	var topmost *BoardSection
+	for i := range board.Sections {
+		section := &board.Sections[i]
+		if section.Kind != "empty" {
+			continue
+		}
+		if topmost == nil || section.YOffset < topmost.YOffset {
+			topmost = section
+		}
+	}
+	if topmost == nil {
+		return "", fmt.Errorf("board %s has no empty section to place the widget in", board.Name)
+	}
+
+	return topmost.ID, nil
+}
+
+// findBoardItem returns the item with the given ID, or nil
+func findBoardItem(board *BoardDetail, id string) *BoardItem {
+	for i := range board.Items {
+		if board.Items[i].ID == id {
+			return &board.Items[i]
+		}
+	}
+	return nil
+}
+
+// primaryItemLayout returns the item's position in the board layout with the most columns
+func primaryItemLayout(board *BoardDetail, item *BoardItem) *BoardItemLayout {
+	columns := make(map[string]int, len(board.Layouts))
+	for _, layout := range board.Layouts {
+		columns[layout.ID] = layout.ColumnCount
+	}
+
+	var primary *BoardItemLayout
+	for i := range item.Layouts {
+		layout := &item.Layouts[i]
+		if primary == nil || columns[layout.LayoutID] > columns[primary.LayoutID] {
+			primary = layout
+		}
+	}
+	return primary
+}
+
+// markdownList formats values as a comma separated list of code spans
+func markdownList(values []string) string {
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = "`" + value + "`"
+	}
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,355 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// placementAttributes are the attributes every widget resource has, which options must not shadow
+var placementAttributes = []string{"id", "board_id", "section_id", "x_offset", "y_offset", "width", "height", "title", "integration_ids"}
+
+func TestWidgetDefinitions(t *testing.T) {
+	ctx := context.Background()
+	names := map[string]bool{}
+
+	for _, definition := range widgetDefinitions() {
+		t.Run(definition.Name, func(t *testing.T) {
+			if names[definition.Name] {
+				t.Fatalf("duplicate widget name %q", definition.Name)
+			}
+			names[definition.Name] = true
+
+			if definition.Kind == "" || definition.Width <= 0 || definition.Height <= 0 {
+				t.Errorf("incomplete definition: kind %q, size %dx%d", definition.Kind, definition.Width, definition.Height)
+			}
+			for _, kind := range definition.IntegrationKinds {
+				if _, ok := findIntegrationDefinition(kind); !ok {
+					t.Errorf("integration kind %q is not in the catalogue", kind)
+				}
+			}
+
+			optionNames := map[string]bool{}
+			optionKeys := map[string]bool{}
+			for _, option := range definition.Options {
+				if optionNames[option.Name] || containsString(placementAttributes, option.Name) {
+					t.Errorf("option name %q is used twice", option.Name)
+				}
+				if optionKeys[option.Key] {
+					t.Errorf("option key %q is used twice", option.Key)
+				}
+				optionNames[option.Name] = true
+				optionKeys[option.Key] = true
+
+				checkWidgetOptionDefault(t, option)
+			}
+
+			resp := &resource.SchemaResponse{}
+			(&WidgetResource{definition: definition}).Schema(ctx, resource.SchemaRequest{}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("schema: %v", resp.Diagnostics)
+			}
+			if diags := resp.Schema.ValidateImplementation(ctx); diags.HasError() {
+				t.Fatalf("schema implementation: %v", diags)
+			}
+		})
+	}
+}
+
+// checkWidgetOptionDefault checks that the default of an option has the option's type and passes its own validation
+func checkWidgetOptionDefault(t *testing.T, option widgetOption) {
+	t.Helper()
+
+	if option.Required {
+		if option.Type != widgetOptionString || option.Default != nil {
+			t.Errorf("%s: only string options without default can be required", option.Name)
+		}
+		return
+	}
+
+	switch option.Type {
+	case widgetOptionBool:
+		if _, ok := option.Default.(bool); !ok {
+			t.Errorf("%s: default %#v is not a bool", option.Name, option.Default)
+		}
+	case widgetOptionString:
+		value, ok := option.Default.(string)
+		if !ok {
+			t.Errorf("%s: default %#v is not a string", option.Name, option.Default)
+			return
+		}
+		if len(option.OneOf) > 0 && !containsString(option.OneOf, value) {
+			t.Errorf("%s: default %q is not one of %v", option.Name, value, option.OneOf)
+		}
+		if option.Pattern != nil && value != "" && !option.Pattern.MatchString(value) {
+			t.Errorf("%s: default %q doesn't match %s", option.Name, value, option.Pattern)
+		}
+	case widgetOptionInt:
+		value, ok := option.Default.(int64)
+		if !ok {
+			t.Errorf("%s: default %#v is not an int64", option.Name, option.Default)
+			return
+		}
+		if option.Max > option.Min && (float64(value) < option.Min || float64(value) > option.Max) {
+			t.Errorf("%s: default %d is outside %g..%g", option.Name, value, option.Min, option.Max)
+		}
+	case widgetOptionNumber:
+		value, ok := option.Default.(float64)
+		if !ok {
+			t.Errorf("%s: default %#v is not a float64", option.Name, option.Default)
+			return
+		}
+		if option.Max > option.Min && (value < option.Min || value > option.Max) {
+			t.Errorf("%s: default %g is outside %g..%g", option.Name, value, option.Min, option.Max)
+		}
+	case widgetOptionStringList:
+		values, ok := option.Default.([]string)
+		if !ok {
+			t.Errorf("%s: default %#v is not a []string", option.Name, option.Default)
+			return
+		}
+		for _, value := range values {
+			if len(option.OneOf) > 0 && !containsString(option.OneOf, value) {
+				t.Errorf("%s: default element %q is not one of %v", option.Name, value, option.OneOf)
+			}
+		}
+	}
+}
+
+func TestWidgetOptionSchemaDefaults(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		option widgetOption
+		want   attr.Value
+	}{
+		{
+			name:   "bool",
+			option: widgetOption{Name: "show", Type: widgetOptionBool, Default: true},
+			want:   types.BoolValue(true),
+		},
+		{
+			name:   "string",
+			option: widgetOption{Name: "mode", Type: widgetOptionString, Default: "compact"},
+			want:   types.StringValue("compact"),
+		},
+		{
+			name:   "int",
+			option: widgetOption{Name: "limit", Type: widgetOptionInt, Default: int64(10)},
+			want:   types.Int64Value(10),
+		},
+		{
+			name:   "number",
+			option: widgetOption{Name: "latitude", Type: widgetOptionNumber, Default: 52.5},
+			want:   types.Float64Value(52.5),
+		},
+		{
+			name:   "string list",
+			option: widgetOption{Name: "charts", Type: widgetOptionStringList, Default: []string{"cpu", "memory"}},
+			want:   types.ListValueMust(types.StringType, []attr.Value{types.StringValue("cpu"), types.StringValue("memory")}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got attr.Value
+			switch a := tt.option.schemaAttribute().(type) {
+			case schema.BoolAttribute:
+				resp := &defaults.BoolResponse{}
+				a.Default.DefaultBool(ctx, defaults.BoolRequest{}, resp)
+				got = resp.PlanValue
+			case schema.StringAttribute:
+				resp := &defaults.StringResponse{}
+				a.Default.DefaultString(ctx, defaults.StringRequest{}, resp)
+				got = resp.PlanValue
+			case schema.Int64Attribute:
+				resp := &defaults.Int64Response{}
+				a.Default.DefaultInt64(ctx, defaults.Int64Request{}, resp)
+				got = resp.PlanValue
+			case schema.Float64Attribute:
+				resp := &defaults.Float64Response{}
+				a.Default.DefaultFloat64(ctx, defaults.Float64Request{}, resp)
+				got = resp.PlanValue
+			case schema.ListAttribute:
+				resp := &defaults.ListResponse{}
+				a.Default.DefaultList(ctx, defaults.ListRequest{}, resp)
+				got = resp.PlanValue
+			default:
+				t.Fatalf("unexpected attribute type %T", a)
+			}
+
+			if !got.Equal(tt.want) {
+				t.Errorf("got default %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	required := widgetOption{Name: "url", Type: widgetOptionString, Required: true}.schemaAttribute().(schema.StringAttribute)
+	if !required.Required || required.Default != nil {
+		t.Errorf("required options must be required and have no default")
+	}
+}
+
+func TestWidgetOptionValidation(t *testing.T) {
+	ctx := context.Background()
+
+	stringOption := widgetOption{Name: "mode", Type: widgetOptionString, Default: "a", OneOf: []string{"a", "b"}}
+	patternOption := widgetOption{Name: "url", Type: widgetOptionString, Required: true, Pattern: regexp.MustCompile(`^https?://`)}
+	listOption := widgetOption{Name: "charts", Type: widgetOptionStringList, Default: []string{}, OneOf: []string{"cpu", "memory"}}
+	intOption := widgetOption{Name: "limit", Type: widgetOptionInt, Default: int64(5), Min: 1, Max: 10}
+
+	validateString := func(option widgetOption, value string) bool {
+		resp := &validator.StringResponse{}
+		for _, v := range option.schemaAttribute().(schema.StringAttribute).Validators {
+			v.ValidateString(ctx, validator.StringRequest{Path: path.Root(option.Name), ConfigValue: types.StringValue(value)}, resp)
+		}
+		return !resp.Diagnostics.HasError()
+	}
+	validateList := func(option widgetOption, values ...string) bool {
+		elements := []attr.Value{}
+		for _, value := range values {
+			elements = append(elements, types.StringValue(value))
+		}
+		resp := &validator.ListResponse{}
+		for _, v := range option.schemaAttribute().(schema.ListAttribute).Validators {
+			v.ValidateList(ctx, validator.ListRequest{Path: path.Root(option.Name), ConfigValue: types.ListValueMust(types.StringType, elements)}, resp)
+		}
+		return !resp.Diagnostics.HasError()
+	}
+	validateInt := func(option widgetOption, value int64) bool {
+		resp := &validator.Int64Response{}
+		for _, v := range option.schemaAttribute().(schema.Int64Attribute).Validators {
+			v.ValidateInt64(ctx, validator.Int64Request{Path: path.Root(option.Name), ConfigValue: types.Int64Value(value)}, resp)
+		}
+		return !resp.Diagnostics.HasError()
+	}
+
+	tests := []struct {
+		name  string
+		valid bool
+		want  bool
+	}{
+		{"one of", validateString(stringOption, "b"), true},
+		{"not one of", validateString(stringOption, "c"), false},
+		{"matching pattern", validateString(patternOption, "https://example.com"), true},
+		{"not matching pattern", validateString(patternOption, "example.com"), false},
+		{"list elements one of", validateList(listOption, "cpu", "memory"), true},
+		{"list element not one of", validateList(listOption, "cpu", "disk"), false},
+		{"int in range", validateInt(intOption, 10), true},
+		{"int out of range", validateInt(intOption, 11), false},
+	}
+
+	for _, tt := range tests {
+		if tt.valid != tt.want {
+			t.Errorf("%s: got valid %t, want %t", tt.name, tt.valid, tt.want)
+		}
+	}
+}
+
+func TestSetWidgetOption(t *testing.T) {
+	options := map[string]interface{}{}
+	setWidgetOption(options, "showSeconds", true)
+	setWidgetOption(options, "location.latitude", 52.5)
+	setWidgetOption(options, "location.longitude", 13.4)
+	setWidgetOption(options, "a.b.c", "deep")
+
+	want := map[string]interface{}{
+		"showSeconds": true,
+		"location":    map[string]interface{}{"latitude": 52.5, "longitude": 13.4},
+		"a":           map[string]interface{}{"b": map[string]interface{}{"c": "deep"}},
+	}
+	if !reflect.DeepEqual(options, want) {
+		t.Fatalf("got %#v, want %#v", options, want)
+	}
+
+	tests := []struct {
+		key    string
+		want   interface{}
+		wantOK bool
+	}{
+		{key: "showSeconds", want: true, wantOK: true},
+		{key: "location.latitude", want: 52.5, wantOK: true},
+		{key: "a.b.c", want: "deep", wantOK: true},
+		{key: "location.name", wantOK: false},
+		{key: "showSeconds.nested", wantOK: false},
+		{key: "missing", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		got, ok := widgetOptionValue(options, tt.key)
+		if ok != tt.wantOK || (ok && got != tt.want) {
+			t.Errorf("%s: got %v, %t, want %v, %t", tt.key, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestMergeWidgetOptions(t *testing.T) {
+	dst := map[string]interface{}{
+		"title":    "old",
+		"location": map[string]interface{}{"name": "Berlin", "latitude": 1.0},
+		"unmanaged": map[string]interface{}{
+			"kept": true,
+		},
+	}
+	src := map[string]interface{}{
+		"title":    "new",
+		"location": map[string]interface{}{"latitude": 52.5},
+	}
+
+	mergeWidgetOptions(dst, src)
+
+	want := map[string]interface{}{
+		"title":     "new",
+		"location":  map[string]interface{}{"name": "Berlin", "latitude": 52.5},
+		"unmanaged": map[string]interface{}{"kept": true},
+	}
+	if !reflect.DeepEqual(dst, want) {
+		t.Errorf("got %#v, want %#v", dst, want)
+	}
+}
+
+func TestWidgetOptionValueFromHomarr(t *testing.T) {
+	tests := []struct {
+		name   string
+		option widgetOption
+		raw    interface{}
+		want   attr.Value
+		wantOK bool
+	}{
+		{name: "bool", option: widgetOption{Type: widgetOptionBool}, raw: true, want: types.BoolValue(true), wantOK: true},
+		{name: "string", option: widgetOption{Type: widgetOptionString}, raw: "x", want: types.StringValue("x"), wantOK: true},
+		{name: "int from JSON number", option: widgetOption{Type: widgetOptionInt}, raw: float64(7), want: types.Int64Value(7), wantOK: true},
+		{name: "number", option: widgetOption{Type: widgetOptionNumber}, raw: 1.5, want: types.Float64Value(1.5), wantOK: true},
+		{
+			name:   "string list",
+			option: widgetOption{Type: widgetOptionStringList},
+			raw:    []interface{}{"a", "b"},
+			want:   types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a"), types.StringValue("b")}),
+			wantOK: true,
+		},
+		{name: "wrong type", option: widgetOption{Type: widgetOptionBool}, raw: "true", wantOK: false},
+		{name: "list with non-string", option: widgetOption{Type: widgetOptionStringList}, raw: []interface{}{"a", 1.0}, wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.option.value(tt.raw)
+			if ok != tt.wantOK {
+				t.Fatalf("got ok %t, want %t", ok, tt.wantOK)
+			}
+			if ok && !got.Equal(tt.want) {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
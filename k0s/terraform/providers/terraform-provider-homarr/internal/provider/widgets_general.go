@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// httpURLPattern matches absolute http and https URLs
+var httpURLPattern = regexp.MustCompile(`^https?://[^\s/$.?#][^\s]*$`)
+
+// stockSymbolPattern matches ticker symbols as used by Yahoo Finance (e.g. AAPL, BRK-B, ^GSPC, EURUSD=X)
+var stockSymbolPattern = regexp.MustCompile(`^[A-Za-z0-9.^=-]{1,20}$`)
+
+// dateFormats are the date formats the clock and weather widgets can show
+var dateFormats = []string{
+	"hide", "dddd, MMMM D", "dddd, D MMMM", "MMM D", "D MMM", "DD/MM/YYYY", "MM/DD/YYYY", "DD/MM", "MM/DD",
+}
+
+// generalWidgetDefinitions are the widgets that don't display an integration
+var generalWidgetDefinitions = []widgetDefinition{
+	{
+		Name:        "clock",
+		Kind:        "clock",
+		Description: "Manages a clock widget showing the current time and date.",
+		Width:       2,
+		Height:      1,
+		Options: []widgetOption{
+			{Name: "custom_title_toggle", Key: "customTitleToggle", Type: widgetOptionBool, Default: false, Description: "Show `custom_title` above the time."},
+			{Name: "custom_title", Key: "customTitle", Type: widgetOptionString, Default: "", Description: "The title shown above the time."},
+			{Name: "is_24_hour_format", Key: "is24HourFormat", Type: widgetOptionBool, Default: true, Description: "Use the 24 hour format."},
+			{Name: "show_seconds", Key: "showSeconds", Type: widgetOptionBool, Default: false, Description: "Show seconds."},
+			{Name: "use_custom_timezone", Key: "useCustomTimezone", Type: widgetOptionBool, Default: false, Description: "Show the time in `timezone` instead of the browser's time zone."},
+			{Name: "timezone", Key: "timezone", Type: widgetOptionString, Default: "Europe/London", StringValidators: []validator.String{timeZone()}, Description: "The IANA time zone used when `use_custom_timezone` is set."},
+			{Name: "show_date", Key: "showDate", Type: widgetOptionBool, Default: true, Description: "Show the date below the time."},
+			{Name: "date_format", Key: "dateFormat", Type: widgetOptionString, Default: "dddd, MMMM D", OneOf: dateFormats, Description: "The format of the date."},
+			{Name: "custom_time_format", Key: "customTimeFormat", Type: widgetOptionString, Default: "", Description: "A custom dayjs time format overriding the time format."},
+			{Name: "custom_date_format", Key: "customDateFormat", Type: widgetOptionString, Default: "", Description: "A custom dayjs date format overriding `date_format`."},
+		},
+	},
+	{
+		Name:        "weather",
+		Kind:        "weather",
+		Description: "Manages a weather widget showing the current weather and forecast of a location. Use the `homarr_location` data source to look up coordinates.",
+		Width:       2,
+		Height:      1,
+		Options: []widgetOption{
+			{Name: "location_name", Key: "location.name", Type: widgetOptionString, Default: "Paris", Description: "The name of the location shown in the widget."},
+			{Name: "latitude", Key: "location.latitude", Type: widgetOptionNumber, Default: 48.85341, Min: -90, Max: 90, Description: "The latitude of the location."},
+			{Name: "longitude", Key: "location.longitude", Type: widgetOptionNumber, Default: 2.3488, Min: -180, Max: 180, Description: "The longitude of the location."},
+			{Name: "show_city", Key: "showCity", Type: widgetOptionBool, Default: false, Description: "Show the name of the location."},
+			{Name: "is_format_fahrenheit", Key: "isFormatFahrenheit", Type: widgetOptionBool, Default: false, Description: "Show temperatures in Fahrenheit instead of Celsius."},
+			{Name: "disable_temperature_decimals", Key: "disableTemperatureDecimals", Type: widgetOptionBool, Default: false, Description: "Round temperatures to whole degrees."},
+			{Name: "show_current_wind_speed", Key: "showCurrentWindSpeed", Type: widgetOptionBool, Default: false, Description: "Show the current wind speed."},
+			{Name: "use_imperial_speed", Key: "useImperialSpeed", Type: widgetOptionBool, Default: false, Description: "Show wind speeds in mph instead of km/h."},
+			{Name: "has_forecast", Key: "hasForecast", Type: widgetOptionBool, Default: false, Description: "Show the forecast."},
+			{Name: "forecast_day_count", Key: "forecastDayCount", Type: widgetOptionInt, Default: int64(5), Min: 1, Max: 7, Description: "The number of forecast days."},
+			{Name: "date_format", Key: "dateFormat", Type: widgetOptionString, Default: "dddd, MMMM D", OneOf: dateFormats, Description: "The format of the forecast dates."},
+		},
+	},
+	{
+		Name:        "rss",
+		Kind:        "rssFeed",
+		Description: "Manages an RSS feed widget showing the latest posts of one or more feeds.",
+		Width:       2,
+		Height:      2,
+		Options: []widgetOption{
+			{Name: "feed_urls", Key: "feedUrls", Type: widgetOptionStringList, Default: []string{}, Pattern: httpURLPattern, Description: "The URLs of the RSS or Atom feeds."},
+			{Name: "enable_rtl", Key: "enableRtl", Type: widgetOptionBool, Default: false, Description: "Show posts right to left."},
+			{Name: "text_lines_clamp", Key: "textLinesClamp", Type: widgetOptionInt, Default: int64(5), Min: 1, Max: 50, Description: "The number of description lines shown per post."},
+			{Name: "maximum_amount_posts", Key: "maximumAmountPosts", Type: widgetOptionInt, Default: int64(100), Min: 1, Max: 9999, Description: "The maximum number of posts shown."},
+			{Name: "hide_description", Key: "hideDescription", Type: widgetOptionBool, Default: false, Description: "Only show the titles of the posts."},
+		},
+	},
+	{
+		Name:        "notebook",
+		Kind:        "notebook",
+		Description: "Manages a notebook widget showing markdown content.",
+		Width:       2,
+		Height:      2,
+		Options: []widgetOption{
+			{Name: "content", Key: "content", Type: widgetOptionString, Default: "", Description: "The markdown content of the notebook. Edits made in the UI show up as drift."},
+			{Name: "show_toolbar", Key: "showToolbar", Type: widgetOptionBool, Default: true, Description: "Show the editor toolbar."},
+			{Name: "allow_read_only_check", Key: "allowReadOnlyCheck", Type: widgetOptionBool, Default: true, Description: "Allow ticking checkboxes without edit permission."},
+		},
+	},
+	{
+		Name:        "iframe",
+		Kind:        "iframe",
+		Description: "Manages an iframe widget embedding a web page, such as a Grafana panel.",
+		Width:       2,
+		Height:      2,
+		Options: []widgetOption{
+			{Name: "embed_url", Key: "embedUrl", Type: widgetOptionString, Required: true, Pattern: httpURLPattern, Description: "The URL of the embedded page."},
+			{Name: "allow_full_screen", Key: "allowFullScreen", Type: widgetOptionBool, Default: false, Description: "Allow the page to go full screen."},
+			{Name: "allow_scrolling", Key: "allowScrolling", Type: widgetOptionBool, Default: true, Description: "Allow scrolling inside the iframe."},
+			{Name: "allow_transparency", Key: "allowTransparency", Type: widgetOptionBool, Default: false, Description: "Allow a transparent background."},
+			{Name: "allow_payment", Key: "allowPayment", Type: widgetOptionBool, Default: false, Description: "Allow the Payment Request API."},
+			{Name: "allow_auto_play", Key: "allowAutoPlay", Type: widgetOptionBool, Default: false, Description: "Allow media to play automatically."},
+			{Name: "allow_microphone", Key: "allowMicrophone", Type: widgetOptionBool, Default: false, Description: "Allow access to the microphone."},
+			{Name: "allow_camera", Key: "allowCamera", Type: widgetOptionBool, Default: false, Description: "Allow access to the camera."},
+			{Name: "allow_geolocation", Key: "allowGeolocation", Type: widgetOptionBool, Default: false, Description: "Allow access to the location."},
+		},
+	},
+	{
+		Name:        "bookmarks",
+		Kind:        "bookmarks",
+		Description: "Manages a bookmarks widget listing links to apps.",
+		Width:       2,
+		Height:      2,
+		Options: []widgetOption{
+			{Name: "bookmarks_title", Key: "title", Type: widgetOptionString, Default: "", Description: "The title shown above the bookmarks."},
+			{Name: "app_ids", Key: "items", Type: widgetOptionStringList, Default: []string{}, Description: "The IDs of the apps to list, in order (e.g., `homarr_app.grafana.id`)."},
+			{Name: "layout", Key: "layout", Type: widgetOptionString, Default: "column", OneOf: []string{"row", "column", "grid", "gridHorizontal"}, Description: "How the bookmarks are arranged."},
+			{Name: "hide_title", Key: "hideTitle", Type: widgetOptionBool, Default: false, Description: "Hide the title."},
+			{Name: "hide_icon", Key: "hideIcon", Type: widgetOptionBool, Default: false, Description: "Hide the app icons."},
+			{Name: "hide_hostname", Key: "hideHostname", Type: widgetOptionBool, Default: false, Description: "Hide the hostnames of the app URLs."},
+			{Name: "open_new_tab", Key: "openNewTab", Type: widgetOptionBool, Default: true, Description: "Open bookmarks in a new tab."},
+		},
+	},
+	{
+		Name:        "video",
+		Kind:        "video",
+		Description: "Manages a video widget showing a video stream, such as a camera feed or a YouTube video.",
+		Width:       2,
+		Height:      2,
+		Options: []widgetOption{
+			{Name: "feed_url", Key: "feedUrl", Type: widgetOptionString, Required: true, Pattern: httpURLPattern, Description: "The URL of the video or stream."},
+			{Name: "has_auto_play", Key: "hasAutoPlay", Type: widgetOptionBool, Default: false, Description: "Start playing automatically."},
+			{Name: "is_muted", Key: "isMuted", Type: widgetOptionBool, Default: true, Description: "Mute the audio."},
+			{Name: "has_controls", Key: "hasControls", Type: widgetOptionBool, Default: false, Description: "Show the player controls."},
+		},
+	},
+	{
+		Name:        "stock_price",
+		Kind:        "stockPrice",
+		Description: "Manages a stock price widget charting the price of a stock.",
+		Width:       2,
+		Height:      1,
+		Options: []widgetOption{
+			{Name: "stock", Key: "stock", Type: widgetOptionString, Default: "AAPL", Pattern: stockSymbolPattern, Description: "The ticker symbol of the stock."},
+			{Name: "time_range", Key: "timeRange", Type: widgetOptionString, Default: "1mo", OneOf: []string{"1d", "5d", "1mo", "3mo", "6mo", "ytd", "1y", "2y", "5y", "10y", "max"}, Description: "The time range charted."},
+			{Name: "time_interval", Key: "timeInterval", Type: widgetOptionString, Default: "1d", OneOf: []string{"5m", "15m", "30m", "1h", "1d", "5d", "1wk", "1mo"}, Description: "The interval between the charted prices."},
+		},
+	},
+}
@@ -0,0 +1,97 @@
+package provider
+
+// downloadColumns are the columns the downloads widget can show and sort by
+var downloadColumns = []string{
+	"id", "actions", "added", "category", "downloadSpeed", "index", "integration", "name",
+	"progress", "ratio", "received", "sent", "size", "state", "time", "type", "upSpeed",
+}
+
+// mediaWidgetDefinitions are the widgets displaying the media stack (media servers, *arr apps and download clients)
+var mediaWidgetDefinitions = []widgetDefinition{
+	{
+		Name:             "media_server",
+		Kind:             "mediaServer",
+		Description:      "Manages a media server widget showing the current streams of Jellyfin, Plex or Emby.",
+		IntegrationKinds: []string{"jellyfin", "plex", "emby"},
+		Width:            2,
+		Height:           2,
+		Options: []widgetOption{
+			{Name: "show_only_playing", Key: "showOnlyPlaying", Type: widgetOptionBool, Default: true, Description: "Only show sessions that are currently playing."},
+		},
+	},
+	{
+		Name:             "media_request_list",
+		Kind:             "mediaRequests-requestList",
+		Description:      "Manages a media request list widget showing the latest Overseerr or Jellyseerr requests.",
+		IntegrationKinds: []string{"overseerr", "jellyseerr"},
+		Width:            2,
+		Height:           2,
+		Options: []widgetOption{
+			{Name: "links_target_new_tab", Key: "linksTargetNewTab", Type: widgetOptionBool, Default: false, Description: "Open request links in a new tab."},
+		},
+	},
+	{
+		Name:             "media_request_stats",
+		Kind:             "mediaRequests-requestStats",
+		Description:      "Manages a media request statistics widget for Overseerr or Jellyseerr.",
+		IntegrationKinds: []string{"overseerr", "jellyseerr"},
+		Width:            2,
+		Height:           2,
+	},
+	{
+		Name:             "downloads",
+		Kind:             "downloads",
+		Description:      "Manages a downloads widget showing the queues of torrent and usenet download clients.",
+		IntegrationKinds: []string{"qBittorrent", "deluge", "transmission", "aria2", "sabNzbd", "nzbGet"},
+		Width:            3,
+		Height:           2,
+		Options: []widgetOption{
+			{Name: "columns", Key: "columns", Type: widgetOptionStringList, Default: []string{"integration", "name", "progress", "time", "actions"}, OneOf: downloadColumns, Description: "The columns to show."},
+			{Name: "enable_row_sorting", Key: "enableRowSorting", Type: widgetOptionBool, Default: false, Description: "Allow sorting the rows by clicking the column headers."},
+			{Name: "default_sort", Key: "defaultSort", Type: widgetOptionString, Default: "type", OneOf: downloadColumns, Description: "The column to sort by."},
+			{Name: "descending_default_sort", Key: "descendingDefaultSort", Type: widgetOptionBool, Default: false, Description: "Sort in descending order."},
+			{Name: "show_completed_usenet", Key: "showCompletedUsenet", Type: widgetOptionBool, Default: true, Description: "Show completed usenet downloads."},
+			{Name: "show_completed_torrent", Key: "showCompletedTorrent", Type: widgetOptionBool, Default: true, Description: "Show completed torrents."},
+			{Name: "active_torrent_threshold", Key: "activeTorrentThreshold", Type: widgetOptionNumber, Default: 0.0, Min: 0, Max: 999999, Description: "Hide completed torrents transferring less than this many KiB/s."},
+			{Name: "category_filter", Key: "categoryFilter", Type: widgetOptionStringList, Default: []string{}, Description: "Categories to filter downloads by."},
+			{Name: "filter_is_whitelist", Key: "filterIsWhitelist", Type: widgetOptionBool, Default: false, Description: "Treat `category_filter` as an allow list instead of a deny list."},
+			{Name: "apply_filter_to_ratio", Key: "applyFilterToRatio", Type: widgetOptionBool, Default: true, Description: "Apply the category filter to the ratio calculation."},
+		},
+	},
+	{
+		Name:             "indexer_manager",
+		Kind:             "indexerManager",
+		Description:      "Manages an indexer manager widget showing the indexer status of Prowlarr.",
+		IntegrationKinds: []string{"prowlarr"},
+		Width:            2,
+		Height:           2,
+		Options: []widgetOption{
+			{Name: "open_indexer_site_in_new_tab", Key: "openIndexerSiteInNewTab", Type: widgetOptionBool, Default: true, Description: "Open indexer sites in a new tab."},
+		},
+	},
+	{
+		Name:             "calendar",
+		Kind:             "calendar",
+		Description:      "Manages a calendar widget showing upcoming releases of the *arr apps and events of calendar integrations.",
+		IntegrationKinds: []string{"sonarr", "radarr", "lidarr", "readarr", "nextcloud"},
+		Width:            2,
+		Height:           2,
+		Options: []widgetOption{
+			{Name: "release_type", Key: "releaseType", Type: widgetOptionStringList, Default: []string{"inCinemas", "digitalRelease"}, OneOf: []string{"inCinemas", "digitalRelease", "physicalRelease"}, Description: "The Radarr release types to show."},
+			{Name: "filter_past_months", Key: "filterPastMonths", Type: widgetOptionInt, Default: int64(2), Min: 2, Max: 9999, Description: "How many months into the past to load releases for."},
+			{Name: "filter_future_months", Key: "filterFutureMonths", Type: widgetOptionInt, Default: int64(2), Min: 2, Max: 9999, Description: "How many months into the future to load releases for."},
+		},
+	},
+	{
+		Name:             "media_transcoding",
+		Kind:             "mediaTranscoding",
+		Description:      "Manages a media transcoding widget showing the workers, queue and statistics of Tdarr.",
+		IntegrationKinds: []string{"tdarr"},
+		Width:            3,
+		Height:           2,
+		Options: []widgetOption{
+			{Name: "default_view", Key: "defaultView", Type: widgetOptionString, Default: "statistics", OneOf: []string{"workers", "queue", "statistics"}, Description: "The view shown when the board is opened."},
+			{Name: "queue_page_size", Key: "queuePageSize", Type: widgetOptionInt, Default: int64(10), Min: 1, Max: 30, Description: "The number of queue entries per page."},
+		},
+	},
+}
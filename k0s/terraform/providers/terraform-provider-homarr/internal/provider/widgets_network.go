@@ -0,0 +1,55 @@
+package provider
+
+// networkWidgetDefinitions are the widgets displaying DNS holes, network controllers and firewalls
+var networkWidgetDefinitions = []widgetDefinition{
+	{
+		Name:             "dns_hole_summary",
+		Kind:             "dnsHoleSummary",
+		Description:      "Manages a DNS hole summary widget showing the blocked queries and domains of Pi-hole or AdGuard Home.",
+		IntegrationKinds: []string{"piHole", "adGuardHome"},
+		Width:            2,
+		Height:           2,
+		Options: []widgetOption{
+			{Name: "use_pi_hole_colors", Key: "usePiHoleColors", Type: widgetOptionBool, Default: true, Description: "Use the colors of the Pi-hole dashboard for the statistics."},
+			{Name: "layout", Key: "layout", Type: widgetOptionString, Default: "grid", OneOf: []string{"grid", "row", "column"}, Description: "How the statistics are arranged."},
+		},
+	},
+	{
+		Name:             "dns_hole_controls",
+		Kind:             "dnsHoleControls",
+		Description:      "Manages a DNS hole controls widget to enable or disable blocking of Pi-hole or AdGuard Home.",
+		IntegrationKinds: []string{"piHole", "adGuardHome"},
+		Width:            2,
+		Height:           2,
+		Options: []widgetOption{
+			{Name: "show_toggle_all_buttons", Key: "showToggleAllButtons", Type: widgetOptionBool, Default: true, Description: "Show buttons to enable or disable all DNS holes at once."},
+		},
+	},
+	{
+		Name:             "network_controller_summary",
+		Kind:             "networkControllerSummary",
+		Description:      "Manages a network controller summary widget showing the WiFi and wired clients of a UniFi controller.",
+		IntegrationKinds: []string{"unifiController"},
+		Width:            2,
+		Height:           2,
+	},
+	{
+		Name:             "network_controller_status",
+		Kind:             "networkControllerStatus",
+		Description:      "Manages a network controller status widget showing the state of the WiFi or wired network of a UniFi controller.",
+		IntegrationKinds: []string{"unifiController"},
+		Width:            1,
+		Height:           1,
+		Options: []widgetOption{
+			{Name: "content", Key: "content", Type: widgetOptionString, Default: "wifi", OneOf: []string{"wifi", "wired"}, Description: "The network shown."},
+		},
+	},
+	{
+		Name:             "firewall",
+		Kind:             "firewall",
+		Description:      "Manages a firewall widget showing the CPU, memory, interface and version information of OPNsense.",
+		IntegrationKinds: []string{"opnsense"},
+		Width:            3,
+		Height:           2,
+	},
+}
@@ -0,0 +1,39 @@
+package provider
+
+import "regexp"
+
+// homeAssistantEntityIDPattern matches Home Assistant entity IDs such as light.living_room
+var homeAssistantEntityIDPattern = regexp.MustCompile(`^[a-z0-9_]+\.[a-z0-9_]+$`)
+
+// homeAssistantAutomationIDPattern matches the entity IDs of Home Assistant automations
+var homeAssistantAutomationIDPattern = regexp.MustCompile(`^automation\.[a-z0-9_]+$`)
+
+// smartHomeWidgetDefinitions are the widgets displaying and controlling Home Assistant entities
+var smartHomeWidgetDefinitions = []widgetDefinition{
+	{
+		Name:             "smart_home_entity_state",
+		Kind:             "smartHome-entityState",
+		Description:      "Manages a smart home entity state widget showing the state of a Home Assistant entity.",
+		IntegrationKinds: []string{"homeAssistant"},
+		Width:            1,
+		Height:           1,
+		Options: []widgetOption{
+			{Name: "entity_id", Key: "entityId", Type: widgetOptionString, Required: true, Pattern: homeAssistantEntityIDPattern, Description: "The Home Assistant entity ID (e.g., `sensor.living_room_temperature`)."},
+			{Name: "display_name", Key: "displayName", Type: widgetOptionString, Default: "", Description: "The name shown in the widget."},
+			{Name: "entity_unit", Key: "entityUnit", Type: widgetOptionString, Default: "", Description: "The unit appended to the state (e.g., `°C`)."},
+			{Name: "clickable", Key: "clickable", Type: widgetOptionBool, Default: false, Description: "Toggle the entity when the widget is clicked."},
+		},
+	},
+	{
+		Name:             "smart_home_execute_automation",
+		Kind:             "smartHome-executeAutomation",
+		Description:      "Manages a smart home automation widget triggering a Home Assistant automation when clicked.",
+		IntegrationKinds: []string{"homeAssistant"},
+		Width:            1,
+		Height:           1,
+		Options: []widgetOption{
+			{Name: "automation_id", Key: "automationId", Type: widgetOptionString, Required: true, Pattern: homeAssistantAutomationIDPattern, Description: "The entity ID of the automation (e.g., `automation.good_night`)."},
+			{Name: "display_name", Key: "displayName", Type: widgetOptionString, Default: "", Description: "The name shown in the widget."},
+		},
+	},
+}
@@ -0,0 +1,38 @@
+package provider
+
+// systemWidgetDefinitions are the widgets monitoring the health and resources of servers and clusters
+var systemWidgetDefinitions = []widgetDefinition{
+	{
+		Name:             "health_monitoring",
+		Kind:             "healthMonitoring",
+		Description:      "Manages a health monitoring widget showing the system health of servers and the nodes, VMs, containers and storage of Proxmox clusters.",
+		IntegrationKinds: []string{"proxmox", "openmediavault", "dashDot", "truenas"},
+		Width:            4,
+		Height:           2,
+		Options: []widgetOption{
+			{Name: "fahrenheit", Key: "fahrenheit", Type: widgetOptionBool, Default: false, Description: "Show CPU temperatures in Fahrenheit instead of Celsius."},
+			{Name: "cpu", Key: "cpu", Type: widgetOptionBool, Default: true, Description: "Show the CPU utilization and temperature."},
+			{Name: "memory", Key: "memory", Type: widgetOptionBool, Default: true, Description: "Show the memory utilization."},
+			{Name: "show_uptime", Key: "showUptime", Type: widgetOptionBool, Default: true, Description: "Show the system uptime."},
+			{Name: "file_system", Key: "fileSystem", Type: widgetOptionBool, Default: true, Description: "Show the file system utilization."},
+			{Name: "default_tab", Key: "defaultTab", Type: widgetOptionString, Default: "system", OneOf: []string{"system", "cluster"}, Description: "The tab shown when the board is opened."},
+			{Name: "section_indicator_requirement", Key: "sectionIndicatorRequirement", Type: widgetOptionString, Default: "all", OneOf: []string{"all", "any"}, Description: "Whether all or any resources of a cluster section must be online for its indicator to be green."},
+			{Name: "visible_cluster_sections", Key: "visibleClusterSections", Type: widgetOptionStringList, Default: []string{"node", "qemu", "lxc", "storage"}, OneOf: []string{"node", "qemu", "lxc", "storage"}, Description: "The sections shown in the cluster tab."},
+		},
+	},
+	{
+		Name:             "system_resources",
+		Kind:             "systemResources",
+		Description:      "Manages a system resources widget charting the CPU, memory and network usage of a server.",
+		IntegrationKinds: []string{"dashDot", "openmediavault", "truenas"},
+		Width:            2,
+		Height:           2,
+		// Homarr 1.x has no point count option: the number of points per chart is fixed by the widget.
+		// The graph settings of the 0.x dashdot widget were not carried over to the 1.x widgets.
+		Options: []widgetOption{
+			{Name: "visible_charts", Key: "visibleCharts", Type: widgetOptionStringList, Default: []string{"cpu", "memory", "network"}, OneOf: []string{"cpu", "memory", "network"}, Description: "The charts shown."},
+			{Name: "label_display_mode", Key: "labelDisplayMode", Type: widgetOptionString, Default: "textWithIcon", OneOf: []string{"textWithIcon", "text", "icon", "hidden"}, Description: "How the chart labels are shown."},
+			{Name: "has_shadow", Key: "hasShadow", Type: widgetOptionBool, Default: true, Description: "Draw a shadow below the chart lines."},
+		},
+	},
+}